@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetCertificate(ctx context.Context) (model.CertInfo, error) {
+	u, err := url.JoinPath(c.baseUrl, model.CertificatePath)
+	if err != nil {
+		return model.CertInfo{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return model.CertInfo{}, err
+	}
+	var info model.CertInfo
+	err = c.baseClient.ExecRequestJSON(req, &info)
+	if err != nil {
+		return model.CertInfo{}, err
+	}
+	return info, nil
+}
+
+func (c *Client) GetCACertificate(ctx context.Context) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.CertificatePath, model.CACertificatePath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) UploadCertificate(ctx context.Context, certReq model.CertUploadReq) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.CertificatePath)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(certReq)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) RenewCertificate(ctx context.Context) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.CertificatePath, model.RenewPath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
@@ -17,23 +17,43 @@
 package client
 
 import (
+	"errors"
 	"github.com/SENERGY-Platform/go-base-http-client"
 	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"io"
 	"net/http"
 )
 
 type Client struct {
 	baseClient *base_client.Client
+	httpClient base_client.HTTPClient
 	baseUrl    string
 }
 
 func New(httpClient base_client.HTTPClient, baseUrl string) *Client {
 	return &Client{
 		baseClient: base_client.New(httpClient, customError, model.HeaderRequestID),
+		httpClient: httpClient,
 		baseUrl:    baseUrl,
 	}
 }
 
+func (c *Client) execRequestStream(req *http.Request) (io.ReadCloser, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		errMsg := resp.Status
+		if b, err := io.ReadAll(resp.Body); err == nil && len(b) > 0 {
+			errMsg = string(b)
+		}
+		return nil, customError(resp.StatusCode, errors.New(errMsg))
+	}
+	return resp.Body, nil
+}
+
 func customError(code int, err error) error {
 	switch code {
 	case http.StatusInternalServerError:
@@ -42,6 +62,8 @@ func customError(code int, err error) error {
 		err = model.NewNotFoundError(err)
 	case http.StatusBadRequest:
 		err = model.NewInvalidInputError(err)
+	case http.StatusForbidden:
+		err = model.NewNotAllowedError(err)
 	}
 	return err
 }
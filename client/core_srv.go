@@ -19,16 +19,52 @@ package client
 import (
 	"context"
 	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"net/http"
+	"net/url"
 )
 
 func (c *Client) GetCoreServices(ctx context.Context) (map[string]model.CoreService, error) {
-	panic("not implemented")
+	u, err := url.JoinPath(c.baseUrl, model.CoreServicesPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	services := make(map[string]model.CoreService)
+	err = c.baseClient.ExecRequestJSON(req, &services)
+	if err != nil {
+		return nil, err
+	}
+	return services, nil
 }
 
 func (c *Client) GetCoreService(ctx context.Context, name string) (model.CoreService, error) {
-	panic("not implemented")
+	u, err := url.JoinPath(c.baseUrl, model.CoreServicesPath, name)
+	if err != nil {
+		return model.CoreService{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return model.CoreService{}, err
+	}
+	var service model.CoreService
+	err = c.baseClient.ExecRequestJSON(req, &service)
+	if err != nil {
+		return model.CoreService{}, err
+	}
+	return service, nil
 }
 
 func (c *Client) RestartCoreService(ctx context.Context, name string) (string, error) {
-	panic("not implemented")
+	u, err := url.JoinPath(c.baseUrl, model.CoreServicesPath, name, model.RestartPath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
 }
@@ -0,0 +1,49 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) CreateDiagnostics(ctx context.Context) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.DiagnosticsPath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) GetDiagnostics(ctx context.Context, id string) (io.ReadCloser, error) {
+	u, err := url.JoinPath(c.baseUrl, model.DiagnosticsPath, id)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.execRequestStream(req)
+}
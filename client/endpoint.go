@@ -63,10 +63,17 @@ func (c *Client) GetEndpoint(ctx context.Context, id string) (model.Endpoint, er
 }
 
 func (c *Client) SetEndpoint(ctx context.Context, endpoint model.EndpointBase) (string, error) {
+	return c.SetEndpointWithOptions(ctx, endpoint, model.EndpointOptions{})
+}
+
+func (c *Client) SetEndpointWithOptions(ctx context.Context, endpoint model.EndpointBase, options model.EndpointOptions) (string, error) {
 	u, err := url.JoinPath(c.baseUrl, model.EndpointsPath)
 	if err != nil {
 		return "", err
 	}
+	if options.Force {
+		u += "?force=true"
+	}
 	body, err := json.Marshal(endpoint)
 	if err != nil {
 		return "", err
@@ -80,10 +87,17 @@ func (c *Client) SetEndpoint(ctx context.Context, endpoint model.EndpointBase) (
 }
 
 func (c *Client) SetEndpoints(ctx context.Context, endpoints []model.EndpointBase) (string, error) {
+	return c.SetEndpointsWithOptions(ctx, endpoints, model.EndpointOptions{})
+}
+
+func (c *Client) SetEndpointsWithOptions(ctx context.Context, endpoints []model.EndpointBase, options model.EndpointOptions) (string, error) {
 	u, err := url.JoinPath(c.baseUrl, model.EndpointsBatchPath)
 	if err != nil {
 		return "", err
 	}
+	if options.Force {
+		u += "?force=true"
+	}
 	body, err := json.Marshal(endpoints)
 	if err != nil {
 		return "", err
@@ -96,11 +110,48 @@ func (c *Client) SetEndpoints(ctx context.Context, endpoints []model.EndpointBas
 	return c.baseClient.ExecRequestString(req)
 }
 
+func (c *Client) SetEndpointsDryRun(ctx context.Context, endpoints []model.EndpointBase) (model.EndpointChanges, error) {
+	return c.SetEndpointsDryRunWithOptions(ctx, endpoints, model.EndpointOptions{})
+}
+
+func (c *Client) SetEndpointsDryRunWithOptions(ctx context.Context, endpoints []model.EndpointBase, options model.EndpointOptions) (model.EndpointChanges, error) {
+	u, err := url.JoinPath(c.baseUrl, model.EndpointsBatchPath)
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	u += "?dry_run=true"
+	if options.Force {
+		u += "&force=true"
+	}
+	body, err := json.Marshal(endpoints)
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewBuffer(body))
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	var changes model.EndpointChanges
+	err = c.baseClient.ExecRequestJSON(req, &changes)
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	return changes, nil
+}
+
 func (c *Client) AddEndpointAlias(ctx context.Context, id, path string) (string, error) {
+	return c.AddEndpointAliasWithOptions(ctx, id, path, model.EndpointOptions{})
+}
+
+func (c *Client) AddEndpointAliasWithOptions(ctx context.Context, id, path string, options model.EndpointOptions) (string, error) {
 	u, err := url.JoinPath(c.baseUrl, model.EndpointsPath, id, model.AliasPath)
 	if err != nil {
 		return "", err
 	}
+	if options.Force {
+		u += "?force=true"
+	}
 	body, err := json.Marshal(model.EndpointAliasReq{
 		Path: path,
 	})
@@ -144,6 +195,92 @@ func (c *Client) RemoveEndpoints(ctx context.Context, filter model.EndpointFilte
 	return c.baseClient.ExecRequestString(req)
 }
 
+func (c *Client) RemoveEndpointsDryRun(ctx context.Context, filter model.EndpointFilter, _ bool) (model.EndpointChanges, error) {
+	u, err := url.JoinPath(c.baseUrl, model.EndpointsBatchPath)
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	if q := genGetEndpointsQuery(filter); q != "" {
+		u += q + "&dry_run=true"
+	} else {
+		u += "?dry_run=true"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	var changes model.EndpointChanges
+	err = c.baseClient.ExecRequestJSON(req, &changes)
+	if err != nil {
+		return model.EndpointChanges{}, err
+	}
+	return changes, nil
+}
+
+func (c *Client) SetEndpointMaintenance(ctx context.Context, id string, maintenance model.EndpointMaintenance) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.EndpointsPath, id, model.MaintenancePath)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(maintenance)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) SetEndpointsMaintenance(ctx context.Context, filter model.EndpointFilter, maintenance model.EndpointMaintenance) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.EndpointsBatchPath, model.MaintenancePath)
+	if err != nil {
+		return "", err
+	}
+	u += genGetEndpointsQuery(filter)
+	body, err := json.Marshal(maintenance)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, u, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) GetEndpointHistory(ctx context.Context) ([]model.EndpointConfigVersion, error) {
+	u, err := url.JoinPath(c.baseUrl, model.EndpointsPath, model.HistoryPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var history []model.EndpointConfigVersion
+	err = c.baseClient.ExecRequestJSON(req, &history)
+	if err != nil {
+		return nil, err
+	}
+	return history, nil
+}
+
+func (c *Client) RestoreEndpoints(ctx context.Context, version int) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.EndpointsPath, model.HistoryPath, strconv.FormatInt(int64(version), 10), model.RestorePath)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
+
 func genGetEndpointsQuery(filter model.EndpointFilter) string {
 	var q []string
 	if filter.Type > 0 {
@@ -17,15 +17,139 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
 	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
-func (c *Client) ListLogs(_ context.Context) ([]model.Log, error) {
-	panic("not implemented")
+func (c *Client) ListLogs(ctx context.Context) ([]model.Log, error) {
+	u, err := url.JoinPath(c.baseUrl, model.LogsPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var logs []model.Log
+	err = c.baseClient.ExecRequestJSON(req, &logs)
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
 }
 
-func (c *Client) GetLog(_ context.Context, id string, numOfLines int) (io.ReadCloser, error) {
-	panic("not implemented")
+func (c *Client) GetLog(ctx context.Context, id string, numOfLines int) (io.ReadCloser, error) {
+	return c.GetLogFiltered(ctx, id, model.LogFilter{MaxLines: numOfLines})
+}
+
+func (c *Client) GetLogFiltered(ctx context.Context, id string, filter model.LogFilter) (io.ReadCloser, error) {
+	u, err := url.JoinPath(c.baseUrl, model.LogsPath, id)
+	if err != nil {
+		return nil, err
+	}
+	u += genLogQuery(filter)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.execRequestStream(req)
+}
+
+func (c *Client) ListLogFiles(ctx context.Context, id string) ([]model.LogFile, error) {
+	u, err := url.JoinPath(c.baseUrl, model.LogsPath, id, model.LogFilesPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var files []model.LogFile
+	err = c.baseClient.ExecRequestJSON(req, &files)
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (c *Client) AddLog(ctx context.Context, log model.LogReq) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.LogsPath)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(log)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) RemoveLog(ctx context.Context, id string) error {
+	u, err := url.JoinPath(c.baseUrl, model.LogsPath, id)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return err
+	}
+	return c.baseClient.ExecRequestVoid(req)
+}
+
+func genLogQuery(filter model.LogFilter) string {
+	var q []string
+	if filter.MaxLines > 0 {
+		q = append(q, "max_lines="+strconv.FormatInt(int64(filter.MaxLines), 10))
+	}
+	if filter.Follow {
+		q = append(q, "follow=true")
+	}
+	if !filter.Since.IsZero() {
+		q = append(q, "since="+url.QueryEscape(filter.Since.Format(time.RFC3339Nano)))
+	}
+	if !filter.Until.IsZero() {
+		q = append(q, "until="+url.QueryEscape(filter.Until.Format(time.RFC3339Nano)))
+	}
+	if filter.Grep != "" {
+		q = append(q, "grep="+url.QueryEscape(filter.Grep))
+	}
+	if filter.GrepRegex {
+		q = append(q, "grep_regex=true")
+	}
+	if filter.Generations > 0 {
+		q = append(q, "generations="+strconv.FormatInt(int64(filter.Generations), 10))
+	}
+	if filter.Level != "" {
+		q = append(q, "level="+url.QueryEscape(filter.Level))
+	}
+	if len(filter.FieldFilter) > 0 {
+		var fl []string
+		for k, v := range filter.FieldFilter {
+			fl = append(fl, k+"="+v)
+		}
+		q = append(q, "filters="+url.QueryEscape(strings.Join(fl, ",")))
+	}
+	if len(filter.Fields) > 0 {
+		q = append(q, "fields="+url.QueryEscape(strings.Join(filter.Fields, ",")))
+	}
+	if filter.Normalize {
+		q = append(q, "normalize=true")
+	}
+	if len(q) > 0 {
+		return "?" + strings.Join(q, "&")
+	}
+	return ""
 }
@@ -18,9 +18,29 @@ package client
 
 import (
 	"context"
+	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
 	srv_info_lib "github.com/SENERGY-Platform/mgw-go-service-base/srv-info-hdl/lib"
+	"net/http"
+	"net/url"
 )
 
 func (c *Client) GetSrvInfo(ctx context.Context) srv_info_lib.SrvInfo {
 	panic("not implemented")
 }
+
+func (c *Client) GetSrvWarnings(ctx context.Context) ([]string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.SrvInfoPath)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	var info model.SrvInfo
+	err = c.baseClient.ExecRequestJSON(req, &info)
+	if err != nil {
+		return nil, err
+	}
+	return info.Warnings, nil
+}
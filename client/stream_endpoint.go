@@ -0,0 +1,131 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) GetStreamEndpoints(ctx context.Context, filter model.StreamEndpointFilter) (map[string]model.StreamEndpoint, error) {
+	u, err := url.JoinPath(c.baseUrl, model.StreamEndpointsPath)
+	if err != nil {
+		return nil, err
+	}
+	u += genStreamEndpointsQuery(filter)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make(map[string]model.StreamEndpoint)
+	err = c.baseClient.ExecRequestJSON(req, &endpoints)
+	if err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
+
+func (c *Client) GetStreamEndpoint(ctx context.Context, id string) (model.StreamEndpoint, error) {
+	u, err := url.JoinPath(c.baseUrl, model.StreamEndpointsPath, id)
+	if err != nil {
+		return model.StreamEndpoint{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return model.StreamEndpoint{}, err
+	}
+	var endpoint model.StreamEndpoint
+	err = c.baseClient.ExecRequestJSON(req, &endpoint)
+	if err != nil {
+		return model.StreamEndpoint{}, err
+	}
+	return endpoint, nil
+}
+
+func (c *Client) SetStreamEndpoint(ctx context.Context, endpoint model.StreamEndpointBase) (string, error) {
+	return c.SetStreamEndpointWithOptions(ctx, endpoint, model.StreamEndpointOptions{})
+}
+
+func (c *Client) SetStreamEndpointWithOptions(ctx context.Context, endpoint model.StreamEndpointBase, options model.StreamEndpointOptions) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.StreamEndpointsPath)
+	if err != nil {
+		return "", err
+	}
+	if options.Force {
+		u += "?force=true"
+	}
+	body, err := json.Marshal(endpoint)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewBuffer(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) RemoveStreamEndpoint(ctx context.Context, id string) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.StreamEndpointsPath, id)
+	if err != nil {
+		return "", err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
+
+func (c *Client) RemoveStreamEndpoints(ctx context.Context, filter model.StreamEndpointFilter) (string, error) {
+	u, err := url.JoinPath(c.baseUrl, model.StreamEndpointsBatchPath)
+	if err != nil {
+		return "", err
+	}
+	u += genStreamEndpointsQuery(filter)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, u, nil)
+	if err != nil {
+		return "", err
+	}
+	return c.baseClient.ExecRequestString(req)
+}
+
+func genStreamEndpointsQuery(filter model.StreamEndpointFilter) string {
+	var q []string
+	if filter.Ref != "" {
+		q = append(q, "ref="+filter.Ref)
+	}
+	if filter.Protocol != "" {
+		q = append(q, "protocol="+filter.Protocol)
+	}
+	if len(filter.Labels) > 0 {
+		q = append(q, "labels="+genLabels(filter.Labels, "=", ","))
+	}
+	if len(filter.IDs) > 0 {
+		q = append(q, "ids="+strings.Join(filter.IDs, ","))
+	}
+	if len(q) > 0 {
+		return "?" + strings.Join(q, "&")
+	}
+	return ""
+}
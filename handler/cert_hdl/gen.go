@@ -0,0 +1,189 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cert_hdl
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"time"
+)
+
+const (
+	caCommonName = "Multi-Gateway Local CA"
+	caValidity   = time.Hour * 24 * 365 * 10
+)
+
+// genCert issues a server certificate for the configured hostnames and IPs. The local CA is created if missing.
+func (h *Handler) genCert() error {
+	caCert, caKey, err := h.getCA()
+	if err != nil {
+		return err
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := genSerial()
+	if err != nil {
+		return err
+	}
+	hostnames := h.hostnames
+	if len(hostnames) == 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return err
+		}
+		hostnames = []string{hostname}
+	}
+	var ips []net.IP
+	for _, s := range h.ips {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return fmt.Errorf("invalid ip '%s'", s)
+		}
+		ips = append(ips, ip)
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: hostnames[0]},
+		DNSNames:     hostnames,
+		IPAddresses:  ips,
+		NotBefore:    now.Add(-time.Minute),
+		NotAfter:     now.Add(h.validity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, caCert, &key.PublicKey, caKey)
+	if err != nil {
+		return err
+	}
+	keyPem, err := encodeKey(key)
+	if err != nil {
+		return err
+	}
+	return writeKeyPair(path.Join(h.path, certFile), encodeCert(der), path.Join(h.path, keyFile), keyPem)
+}
+
+func (h *Handler) getCA() (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	caCert, err := readCert(path.Join(h.path, caCertFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, nil, err
+		}
+		return h.genCA()
+	}
+	b, err := os.ReadFile(path.Join(h.path, caKeyFile))
+	if err != nil {
+		return nil, nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, nil, errors.New("decoding ca key failed")
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	caKey, ok := k.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, nil, errors.New("invalid ca key type")
+	}
+	return caCert, caKey, nil
+}
+
+func (h *Handler) genCA() (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	serial, err := genSerial()
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: caCommonName},
+		NotBefore:             now.Add(-time.Minute),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	keyPem, err := encodeKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = writeKeyPair(path.Join(h.path, caCertFile), encodeCert(der), path.Join(h.path, caKeyFile), keyPem); err != nil {
+		return nil, nil, err
+	}
+	return cert, key, nil
+}
+
+func genSerial() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+}
+
+func encodeCert(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+func readCert(p string) (*x509.Certificate, error) {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("decoding '%s' failed", p)
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+func writeKeyPair(certPath string, cert []byte, keyPath string, key []byte) error {
+	if err := os.WriteFile(keyPath, key, 0600); err != nil {
+		return err
+	}
+	return os.WriteFile(certPath, cert, 0644)
+}
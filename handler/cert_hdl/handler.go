@@ -0,0 +1,267 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cert_hdl
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"github.com/tufanbarisyildirim/gonginx/dumper"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	caCertFile = "ca.crt"
+	caKeyFile  = "ca.key"
+	certFile   = "server.crt"
+	keyFile    = "server.key"
+)
+
+type Handler struct {
+	ctrHdl      ContainerHandler
+	path        string
+	sslConfPath string
+	hostnames   []string
+	ips         []string
+	validity    time.Duration
+	renewBefore time.Duration
+	warnBefore  time.Duration
+	info        *lib_model.CertInfo
+	m           sync.RWMutex
+}
+
+func New(containerHandler ContainerHandler, path, sslConfPath string, hostnames, ips []string, validity, renewBefore, warnBefore time.Duration) *Handler {
+	return &Handler{
+		ctrHdl:      containerHandler,
+		path:        path,
+		sslConfPath: sslConfPath,
+		hostnames:   hostnames,
+		ips:         ips,
+		validity:    validity,
+		renewBefore: renewBefore,
+		warnBefore:  warnBefore,
+	}
+}
+
+// Init creates the local CA and a server certificate if no certificate exists and writes the ssl config.
+func (h *Handler) Init() error {
+	if h.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(h.path, 0770); err != nil {
+		return err
+	}
+	if _, err := os.Stat(path.Join(h.path, certFile)); err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if err = h.genCert(); err != nil {
+			return err
+		}
+	}
+	if err := h.loadInfo(); err != nil {
+		return err
+	}
+	return h.writeSslConf()
+}
+
+func (h *Handler) Get(_ context.Context) (lib_model.CertInfo, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	if h.info == nil {
+		return lib_model.CertInfo{}, lib_model.NewNotFoundError(errors.New("certificate not found"))
+	}
+	return *h.info, nil
+}
+
+func (h *Handler) GetCA(_ context.Context) (string, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	if h.path == "" {
+		return "", lib_model.NewNotFoundError(errors.New("ca certificate not found"))
+	}
+	b, err := os.ReadFile(path.Join(h.path, caCertFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", lib_model.NewNotFoundError(errors.New("ca certificate not found"))
+		}
+		return "", lib_model.NewInternalError(err)
+	}
+	return string(b), nil
+}
+
+// Upload replaces the server certificate with the provided certificate and key. Uploaded certificates are not
+// renewed automatically.
+func (h *Handler) Upload(ctx context.Context, req lib_model.CertUploadReq) error {
+	if h.path == "" {
+		return lib_model.NewInvalidInputError(errors.New("certificates not supported"))
+	}
+	pair, err := tls.X509KeyPair([]byte(req.Cert), []byte(req.Key))
+	if err != nil {
+		return lib_model.NewInvalidInputError(err)
+	}
+	leaf, err := x509.ParseCertificate(pair.Certificate[0])
+	if err != nil {
+		return lib_model.NewInvalidInputError(err)
+	}
+	if time.Now().After(leaf.NotAfter) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("certificate expired at %s", leaf.NotAfter.Format(time.RFC3339)))
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	return h.update(ctx, func() error {
+		return writeKeyPair(path.Join(h.path, certFile), []byte(req.Cert), path.Join(h.path, keyFile), []byte(req.Key))
+	})
+}
+
+// Renew issues a new server certificate signed by the local CA.
+func (h *Handler) Renew(ctx context.Context) error {
+	if h.path == "" {
+		return lib_model.NewInvalidInputError(errors.New("certificates not supported"))
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	return h.update(ctx, h.genCert)
+}
+
+// NeedsRenewal returns true if a certificate issued by the local CA expires within the configured renewal period.
+func (h *Handler) NeedsRenewal() bool {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	return h.info != nil && h.info.Generated && time.Until(h.info.NotAfter) < h.renewBefore
+}
+
+// Warnings returns a message if the certificate expires within the configured warning period.
+func (h *Handler) Warnings() []string {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	if h.info == nil {
+		return nil
+	}
+	remaining := time.Until(h.info.NotAfter)
+	if remaining <= 0 {
+		return []string{fmt.Sprintf("certificate expired at %s", h.info.NotAfter.Format(time.RFC3339))}
+	}
+	if remaining < h.warnBefore {
+		return []string{fmt.Sprintf("certificate expires at %s", h.info.NotAfter.Format(time.RFC3339))}
+	}
+	return nil
+}
+
+func (h *Handler) update(ctx context.Context, writeFunc func() error) error {
+	crtPath, kPath := path.Join(h.path, certFile), path.Join(h.path, keyFile)
+	if err := backup(crtPath); err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if err := backup(kPath); err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if ctx.Err() != nil {
+		return lib_model.NewInternalError(ctx.Err())
+	}
+	if err := writeFunc(); err != nil {
+		h.restore()
+		return lib_model.NewInternalError(err)
+	}
+	if err := h.writeSslConf(); err != nil {
+		h.restore()
+		return lib_model.NewInternalError(err)
+	}
+	if err := h.ctrHdl.ExecCmd(ctx, []string{"nginx", "-t"}, false, nil, ""); err != nil {
+		h.restore()
+		var execErr *util.ExecError
+		if errors.As(err, &execErr) && ctx.Err() == nil {
+			return lib_model.NewInvalidInputError(fmt.Errorf("config test failed: %s", strings.TrimSpace(execErr.Output)))
+		}
+		return lib_model.NewInternalError(err)
+	}
+	if err := h.ctrHdl.ExecCmd(ctx, []string{"nginx", "-s", "reload"}, true, nil, ""); err != nil {
+		h.restore()
+		return lib_model.NewInternalError(err)
+	}
+	if err := h.loadInfo(); err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) restore() {
+	for _, p := range []string{path.Join(h.path, certFile), path.Join(h.path, keyFile)} {
+		if err := os.Rename(p+".bk", p); err != nil {
+			util.Logger.Error(err)
+		}
+	}
+}
+
+func (h *Handler) loadInfo() error {
+	cert, err := readCert(path.Join(h.path, certFile))
+	if err != nil {
+		return err
+	}
+	info := lib_model.CertInfo{
+		Subject:   cert.Subject.String(),
+		Issuer:    cert.Issuer.String(),
+		DNSNames:  cert.DNSNames,
+		NotBefore: cert.NotBefore,
+		NotAfter:  cert.NotAfter,
+	}
+	for _, ip := range cert.IPAddresses {
+		info.IPs = append(info.IPs, ip.String())
+	}
+	caCert, err := readCert(path.Join(h.path, caCertFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if caCert != nil {
+		info.Generated = cert.CheckSignatureFrom(caCert) == nil
+	}
+	h.info = &info
+	return nil
+}
+
+func (h *Handler) writeSslConf() error {
+	if h.sslConfPath == "" {
+		return nil
+	}
+	return dumper.WriteConfig(&config.Config{
+		Block: &config.Block{
+			Directives: []config.IDirective{
+				&config.Directive{Name: "ssl_certificate", Parameters: []string{path.Join(h.path, certFile)}},
+				&config.Directive{Name: "ssl_certificate_key", Parameters: []string{path.Join(h.path, keyFile)}},
+				&config.Directive{Name: "ssl_protocols", Parameters: []string{"TLSv1.2", "TLSv1.3"}},
+			},
+		},
+		FilePath: h.sslConfPath,
+	}, dumper.IndentedStyle, false)
+}
+
+func backup(p string) error {
+	b, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(p+".bk", b, 0600)
+}
@@ -0,0 +1,210 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cert_hdl
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ctrHandlerMock struct {
+	testErr error
+}
+
+func (m *ctrHandlerMock) ExecCmd(_ context.Context, cmd []string, _ bool, _ map[string]string, _ string) error {
+	if slices.Contains(cmd, "-t") {
+		return m.testErr
+	}
+	return nil
+}
+
+func newTestHandler(t *testing.T, validity, renewBefore time.Duration) *Handler {
+	dir := t.TempDir()
+	h := New(&ctrHandlerMock{}, path.Join(dir, "certs"), path.Join(dir, "ssl.conf"), []string{"gateway.local"}, []string{"192.168.1.2"}, validity, renewBefore, renewBefore)
+	if err := h.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func genTestKeyPair(t *testing.T, notAfter time.Time) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, err := genSerial()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "test"},
+		DNSNames:     []string{"test"},
+		NotBefore:    notAfter.Add(-time.Hour * 48),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPem, err := encodeKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(encodeCert(der)), string(keyPem)
+}
+
+func TestInit(t *testing.T) {
+	h := newTestHandler(t, time.Hour*24, time.Hour)
+	info, err := h.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.Generated {
+		t.Error("expected generated certificate")
+	}
+	if !slices.Equal(info.DNSNames, []string{"gateway.local"}) || !slices.Equal(info.IPs, []string{"192.168.1.2"}) {
+		t.Errorf("unexpected names %v %v", info.DNSNames, info.IPs)
+	}
+	if info.Issuer != "CN="+caCommonName {
+		t.Errorf("unexpected issuer '%s'", info.Issuer)
+	}
+	if _, err = h.GetCA(context.Background()); err != nil {
+		t.Error(err)
+	}
+	b, err := os.ReadFile(h.sslConfPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), path.Join(h.path, certFile)) {
+		t.Errorf("ssl config does not reference certificate: %s", b)
+	}
+	// existing certificates are kept
+	h2 := New(&ctrHandlerMock{}, h.path, h.sslConfPath, nil, nil, time.Hour, time.Hour, time.Hour)
+	if err = h2.Init(); err != nil {
+		t.Fatal(err)
+	}
+	if !h2.info.NotAfter.Equal(info.NotAfter) {
+		t.Error("expected existing certificate")
+	}
+}
+
+func TestUpload(t *testing.T) {
+	validCert, validKey := genTestKeyPair(t, time.Now().Add(time.Hour*24))
+	expiredCert, expiredKey := genTestKeyPair(t, time.Now().Add(-time.Hour))
+	_, otherKey := genTestKeyPair(t, time.Now().Add(time.Hour*24))
+	tests := []struct {
+		name    string
+		req     lib_model.CertUploadReq
+		testErr error
+		invalid bool
+	}{
+		{name: "invalid pem", req: lib_model.CertUploadReq{Cert: "test", Key: "test"}, invalid: true},
+		{name: "key mismatch", req: lib_model.CertUploadReq{Cert: validCert, Key: otherKey}, invalid: true},
+		{name: "expired", req: lib_model.CertUploadReq{Cert: expiredCert, Key: expiredKey}, invalid: true},
+		{name: "config test failed", req: lib_model.CertUploadReq{Cert: validCert, Key: validKey}, testErr: lib_model.NewInternalError(&util.ExecError{Cmd: []string{"nginx", "-t"}, Output: "nginx: [emerg] cannot load certificate\n"}), invalid: true},
+		{name: "exec failed", req: lib_model.CertUploadReq{Cert: validCert, Key: validKey}, testErr: lib_model.NewInternalError(errors.New("connection refused"))},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newTestHandler(t, time.Hour*24, time.Hour)
+			orgCert, err := os.ReadFile(path.Join(h.path, certFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			h.ctrHdl = &ctrHandlerMock{testErr: tc.testErr}
+			err = h.Upload(context.Background(), tc.req)
+			var iie *lib_model.InvalidInputError
+			if tc.invalid != errors.As(err, &iie) {
+				t.Errorf("expected invalid input error: %v, got '%v'", tc.invalid, err)
+			}
+			var ie *lib_model.InternalError
+			if !tc.invalid && !errors.As(err, &ie) {
+				t.Errorf("expected internal error, got '%v'", err)
+			}
+			b, err := os.ReadFile(path.Join(h.path, certFile))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != string(orgCert) {
+				t.Error("expected original certificate")
+			}
+		})
+	}
+	t.Run("valid", func(t *testing.T) {
+		h := newTestHandler(t, time.Hour*24, time.Hour*48)
+		if err := h.Upload(context.Background(), lib_model.CertUploadReq{Cert: validCert, Key: validKey}); err != nil {
+			t.Fatal(err)
+		}
+		info, err := h.Get(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Generated || info.Subject != "CN=test" {
+			t.Errorf("unexpected certificate info %+v", info)
+		}
+		if h.NeedsRenewal() {
+			t.Error("uploaded certificates must not be renewed")
+		}
+	})
+}
+
+func TestRenew(t *testing.T) {
+	h := newTestHandler(t, time.Hour, time.Hour*2)
+	if !h.NeedsRenewal() {
+		t.Fatal("expected renewal")
+	}
+	if len(h.Warnings()) == 0 {
+		t.Error("expected warning")
+	}
+	notAfter := h.info.NotAfter
+	h.validity = time.Hour * 24
+	if err := h.Renew(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if !h.info.NotAfter.After(notAfter) {
+		t.Error("expected new certificate")
+	}
+	if h.NeedsRenewal() {
+		t.Error("expected no renewal")
+	}
+	if len(h.Warnings()) > 0 {
+		t.Errorf("unexpected warnings %v", h.Warnings())
+	}
+	h.ctrHdl = &ctrHandlerMock{testErr: lib_model.NewInternalError(errors.New("connection refused"))}
+	notAfter = h.info.NotAfter
+	var ie *lib_model.InternalError
+	if err := h.Renew(context.Background()); !errors.As(err, &ie) {
+		t.Errorf("expected internal error, got '%v'", err)
+	}
+	if !h.info.NotAfter.Equal(notAfter) {
+		t.Error("expected previous certificate")
+	}
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diag_hdl
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	job_hdl_lib "github.com/SENERGY-Platform/mgw-go-service-base/job-hdl/lib"
+	"io"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	bundleExt   = ".tar.gz"
+	redactedVal = "***"
+)
+
+var idRegex = regexp.MustCompile(`^[0-9a-f]+$`)
+
+var invalidNameCharsRegex = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+var secretKeys = []string{"secret", "password", "token", "key"}
+
+type Handler struct {
+	logHdl     LogHandler
+	coreSrvHdl CoreServiceHandler
+	endpntHdl  EndpointHandler
+	jobHdl     JobHandler
+	config     any
+	workPath   string
+	maxBundles int
+	logLines   int
+}
+
+func New(logHdl LogHandler, coreSrvHdl CoreServiceHandler, endpointHdl EndpointHandler, jobHdl JobHandler, config any, workPath string, maxBundles, logLines int) *Handler {
+	return &Handler{
+		logHdl:     logHdl,
+		coreSrvHdl: coreSrvHdl,
+		endpntHdl:  endpointHdl,
+		jobHdl:     jobHdl,
+		config:     config,
+		workPath:   workPath,
+		maxBundles: maxBundles,
+		logLines:   logLines,
+	}
+}
+
+func (h *Handler) Init() error {
+	return os.MkdirAll(h.workPath, 0770)
+}
+
+func (h *Handler) Create(ctx context.Context) (string, error) {
+	files, err := h.collect(ctx)
+	if err != nil {
+		return "", lib_model.NewInternalError(err)
+	}
+	id := util.GenHash(strconv.FormatInt(time.Now().UnixNano(), 10))
+	if err = writeBundle(path.Join(h.workPath, id+bundleExt), files); err != nil {
+		return "", lib_model.NewInternalError(err)
+	}
+	if err = h.prune(); err != nil {
+		util.Logger.Error(err)
+	}
+	return id, nil
+}
+
+func (h *Handler) Get(_ context.Context, id string) (io.ReadCloser, error) {
+	if !idRegex.MatchString(id) {
+		return nil, lib_model.NewInvalidInputError(fmt.Errorf("invalid id '%s'", id))
+	}
+	file, err := os.Open(path.Join(h.workPath, id+bundleExt))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, lib_model.NewNotFoundError(fmt.Errorf("diagnostics bundle '%s' not found", id))
+		}
+		return nil, lib_model.NewInternalError(err)
+	}
+	return file, nil
+}
+
+func (h *Handler) collect(ctx context.Context) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	services, err := h.coreSrvHdl.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if files["core_services.json"], err = json.MarshalIndent(services, "", "  "); err != nil {
+		return nil, err
+	}
+	configs, err := h.endpntHdl.GetConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for name, b := range configs {
+		files[path.Join("endpoints", name)] = b
+	}
+	config, err := sanitize(h.config)
+	if err != nil {
+		return nil, err
+	}
+	if files["config.json"], err = json.MarshalIndent(config, "", "  "); err != nil {
+		return nil, err
+	}
+	jobs, err := h.jobHdl.List(ctx, job_hdl_lib.JobFilter{SortDesc: true})
+	if err != nil {
+		return nil, err
+	}
+	if files["jobs.json"], err = json.MarshalIndent(jobs, "", "  "); err != nil {
+		return nil, err
+	}
+	logs, err := h.logHdl.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, log := range logs {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		name := path.Join("logs", invalidNameCharsRegex.ReplaceAllString(log.ServiceName, "_")+"_"+log.ID[:8]+".log")
+		b, err := h.readLog(ctx, log.ID)
+		if err != nil {
+			util.Logger.Errorf("diagnostics: reading log '%s' failed: %s", log.ServiceName, err)
+			b = []byte(err.Error())
+		}
+		files[name] = b
+	}
+	return files, nil
+}
+
+func (h *Handler) readLog(ctx context.Context, id string) ([]byte, error) {
+	rc, err := h.logHdl.GetReader(ctx, id, lib_model.LogFilter{MaxLines: h.logLines})
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return io.ReadAll(rc)
+}
+
+func (h *Handler) prune() error {
+	if h.maxBundles <= 0 {
+		return nil
+	}
+	entries, err := os.ReadDir(h.workPath)
+	if err != nil {
+		return err
+	}
+	var bundles []os.FileInfo
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), bundleExt) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		bundles = append(bundles, info)
+	}
+	if len(bundles) <= h.maxBundles {
+		return nil
+	}
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].ModTime().After(bundles[j].ModTime())
+	})
+	for _, info := range bundles[h.maxBundles:] {
+		if err = os.Remove(path.Join(h.workPath, info.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeBundle(p string, files map[string][]byte) error {
+	file, err := os.OpenFile(p+".tmp", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0660)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(p + ".tmp")
+	defer file.Close()
+	gw := gzip.NewWriter(file)
+	tw := tar.NewWriter(gw)
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	modTime := time.Now()
+	for _, name := range names {
+		err = tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0644,
+			Size:    int64(len(files[name])),
+			ModTime: modTime,
+		})
+		if err != nil {
+			return err
+		}
+		if _, err = tw.Write(files[name]); err != nil {
+			return err
+		}
+	}
+	if err = tw.Close(); err != nil {
+		return err
+	}
+	if err = gw.Close(); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(p+".tmp", p)
+}
+
+// sanitize converts v to a generic JSON structure and redacts string values of keys that indicate secrets.
+func sanitize(v any) (any, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m any
+	if err = json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return redact(m), nil
+}
+
+func redact(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for key, item := range val {
+			if _, ok := item.(string); ok && isSecretKey(key) {
+				if item != "" {
+					val[key] = redactedVal
+				}
+				continue
+			}
+			val[key] = redact(item)
+		}
+	case []any:
+		for i, item := range val {
+			val[i] = redact(item)
+		}
+	}
+	return v
+}
+
+func isSecretKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, s := range secretKeys {
+		if strings.Contains(key, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,180 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diag_hdl
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestRedact(t *testing.T) {
+	v := map[string]any{
+		"name":        "test",
+		"password":    "secret",
+		"ApiToken":    "abc",
+		"empty_key":   "",
+		"port":        float64(80),
+		"secret_port": float64(443),
+		"nested": map[string]any{
+			"client_secret": "abc",
+			"url":           "http://test",
+			"list": []any{
+				map[string]any{"key": "abc", "value": "test"},
+				"password",
+				[]any{map[string]any{"auth_token": "abc"}},
+			},
+		},
+		"tokens": []any{"a", "b"},
+	}
+	want := map[string]any{
+		"name":        "test",
+		"password":    redactedVal,
+		"ApiToken":    redactedVal,
+		"empty_key":   "",
+		"port":        float64(80),
+		"secret_port": float64(443),
+		"nested": map[string]any{
+			"client_secret": redactedVal,
+			"url":           "http://test",
+			"list": []any{
+				map[string]any{"key": redactedVal, "value": "test"},
+				"password",
+				[]any{map[string]any{"auth_token": redactedVal}},
+			},
+		},
+		"tokens": []any{"a", "b"},
+	}
+	if got := redact(v); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestSanitize(t *testing.T) {
+	type auth struct {
+		User     string `json:"user"`
+		Password string `json:"password"`
+	}
+	v := struct {
+		Auth  auth   `json:"auth"`
+		Hosts []auth `json:"hosts"`
+	}{
+		Auth:  auth{User: "a", Password: "b"},
+		Hosts: []auth{{User: "c", Password: "d"}},
+	}
+	got, err := sanitize(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"auth":  map[string]any{"user": "a", "password": redactedVal},
+		"hosts": []any{map[string]any{"user": "c", "password": redactedVal}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if v.Auth.Password != "b" {
+		t.Error("original value must not be modified")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		p := path.Join(dir, strconv.Itoa(i)+bundleExt)
+		if err := os.WriteFile(p, nil, 0660); err != nil {
+			t.Fatal(err)
+		}
+		mt := now.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(p, mt, mt); err != nil {
+			t.Fatal(err)
+		}
+	}
+	other := path.Join(dir, "other.txt")
+	if err := os.WriteFile(other, nil, 0660); err != nil {
+		t.Fatal(err)
+	}
+	h := &Handler{workPath: dir, maxBundles: 2}
+	if err := h.prune(); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		_, err := os.Stat(path.Join(dir, strconv.Itoa(i)+bundleExt))
+		if i >= 3 && err != nil {
+			t.Errorf("expected bundle %d, got %s", i, err)
+		}
+		if i < 3 && !os.IsNotExist(err) {
+			t.Errorf("expected removal of bundle %d", i)
+		}
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWriteBundle(t *testing.T) {
+	p := path.Join(t.TempDir(), "test"+bundleExt)
+	files := map[string][]byte{
+		"logs/b.log":  []byte("b"),
+		"config.json": []byte("{}"),
+		"logs/a.log":  []byte("a"),
+	}
+	if err := writeBundle(p, files); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(p + ".tmp"); !os.IsNotExist(err) {
+		t.Error("unexpected temporary file")
+	}
+	file, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gr, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gr)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != string(files[hdr.Name]) {
+			t.Errorf("%s: expected '%s', got '%s'", hdr.Name, files[hdr.Name], b)
+		}
+		names = append(names, hdr.Name)
+	}
+	if want := []string{"config.json", "logs/a.log", "logs/b.log"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("expected %v, got %v", want, names)
+	}
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package diag_hdl
+
+import (
+	"context"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	job_hdl_lib "github.com/SENERGY-Platform/mgw-go-service-base/job-hdl/lib"
+	"io"
+)
+
+type LogHandler interface {
+	List(ctx context.Context) ([]lib_model.Log, error)
+	GetReader(ctx context.Context, id string, filter lib_model.LogFilter) (io.ReadCloser, error)
+}
+
+type CoreServiceHandler interface {
+	List(ctx context.Context) (map[string]lib_model.CoreService, error)
+}
+
+type EndpointHandler interface {
+	GetConfigs(ctx context.Context) (map[string][]byte, error)
+}
+
+type JobHandler interface {
+	List(ctx context.Context, filter job_hdl_lib.JobFilter) ([]job_hdl_lib.Job, error)
+}
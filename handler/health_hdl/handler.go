@@ -0,0 +1,220 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package health_hdl
+
+import (
+	"context"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	logPrefix            = "[health-hdl]"
+	defaultCheckInterval = time.Second * 30
+)
+
+type result struct {
+	status  string
+	checked time.Time
+}
+
+type Handler struct {
+	endpointHdl EndpointHandler
+	httpClient  *http.Client
+	results     map[string]result
+	mu          sync.RWMutex
+	running     bool
+	loopMu      sync.RWMutex
+	dChan       chan struct{}
+}
+
+func New(endpointHdl EndpointHandler, timeout time.Duration) *Handler {
+	return &Handler{
+		endpointHdl: endpointHdl,
+		httpClient: &http.Client{
+			Timeout: timeout,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		results: make(map[string]result),
+		dChan:   make(chan struct{}),
+	}
+}
+
+// GetStatus returns the last probe result of an endpoint or an empty string if no health check is defined.
+// Aliases share the status of their parent endpoint.
+func (h *Handler) GetStatus(endpoint lib_model.Endpoint) string {
+	if endpoint.HealthCheck == nil {
+		return ""
+	}
+	id := endpoint.ID
+	if endpoint.ParentID != "" {
+		id = endpoint.ParentID
+	}
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	r, ok := h.results[id]
+	if !ok {
+		return lib_model.EndpointUnknown
+	}
+	return r.status
+}
+
+func (h *Handler) Start(ctx context.Context, interval time.Duration) {
+	go h.run(ctx, interval)
+}
+
+func (h *Handler) Running() bool {
+	h.loopMu.RLock()
+	defer h.loopMu.RUnlock()
+	return h.running
+}
+
+func (h *Handler) Wait() {
+	<-h.dChan
+}
+
+func (h *Handler) run(ctx context.Context, interval time.Duration) {
+	h.loopMu.Lock()
+	h.running = true
+	h.loopMu.Unlock()
+	timer := time.NewTimer(interval)
+	loop := true
+	for loop {
+		select {
+		case <-timer.C:
+			if err := h.check(ctx); err != nil {
+				util.Logger.Errorf("%s %s", logPrefix, err)
+			}
+			timer.Reset(interval)
+		case <-ctx.Done():
+			loop = false
+			break
+		}
+	}
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	h.loopMu.Lock()
+	h.running = false
+	h.loopMu.Unlock()
+	h.dChan <- struct{}{}
+}
+
+// check probes all endpoints whose health check interval has elapsed and drops results of removed endpoints.
+func (h *Handler) check(ctx context.Context) error {
+	endpoints, err := h.endpointHdl.List(ctx, lib_model.EndpointFilter{})
+	if err != nil {
+		return err
+	}
+	h.mu.RLock()
+	results := make(map[string]result)
+	for id, r := range h.results {
+		results[id] = r
+	}
+	h.mu.RUnlock()
+	newResults := make(map[string]result)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for id, endpoint := range endpoints {
+		if endpoint.HealthCheck == nil || endpoint.ParentID != "" {
+			continue
+		}
+		interval := endpoint.HealthCheck.Interval
+		if interval <= 0 {
+			interval = defaultCheckInterval
+		}
+		if r, ok := results[id]; ok && time.Since(r.checked) < interval {
+			newResults[id] = r
+			continue
+		}
+		wg.Add(1)
+		go func(id string, endpoint lib_model.Endpoint) {
+			defer wg.Done()
+			status := h.probe(ctx, endpoint)
+			mu.Lock()
+			newResults[id] = result{status: status, checked: time.Now()}
+			mu.Unlock()
+		}(id, endpoint)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		return nil
+	}
+	h.mu.Lock()
+	h.results = newResults
+	h.mu.Unlock()
+	return nil
+}
+
+func (h *Handler) probe(ctx context.Context, endpoint lib_model.Endpoint) string {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, genUrl(endpoint), nil)
+	if err != nil {
+		util.Logger.Errorf("%s %s", logPrefix, err)
+		return lib_model.EndpointUnknown
+	}
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		util.Logger.Debugf("%s probing endpoint '%s' failed: %s", logPrefix, endpoint.ID, err)
+		return lib_model.EndpointUnhealthy
+	}
+	resp.Body.Close()
+	if endpoint.HealthCheck.ExpectedStatus > 0 {
+		if resp.StatusCode == endpoint.HealthCheck.ExpectedStatus {
+			return lib_model.EndpointHealthy
+		}
+	} else if resp.StatusCode >= 200 && resp.StatusCode < 400 {
+		return lib_model.EndpointHealthy
+	}
+	util.Logger.Debugf("%s probing endpoint '%s' returned status '%d'", logPrefix, endpoint.ID, resp.StatusCode)
+	return lib_model.EndpointUnhealthy
+}
+
+func genUrl(endpoint lib_model.Endpoint) string {
+	host, port := endpoint.Host, endpoint.Port
+	if endpoint.Upstream != nil {
+		for _, target := range endpoint.Upstream.Targets {
+			if !target.Backup {
+				host, port = target.Host, target.Port
+				break
+			}
+		}
+	}
+	if port != nil {
+		host += ":" + strconv.FormatInt(int64(*port), 10)
+	}
+	p := endpoint.HealthCheck.Path
+	if p == "" {
+		p = endpoint.IntPath
+	}
+	u := url.URL{
+		Scheme: "http",
+		Host:   host,
+		Path:   path.Join("/", p),
+	}
+	return u.String()
+}
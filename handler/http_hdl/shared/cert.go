@@ -0,0 +1,65 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package shared
+
+import (
+	"github.com/SENERGY-Platform/mgw-core-manager/lib"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+)
+
+// GetCertificateH
+// @Summary Get certificate
+// @Description	Get information about the certificate used by the core reverse proxy.
+// @Tags Certificates
+// @Produce	json
+// @Success	200 {object} lib_model.CertInfo "certificate information"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /certificate [get]
+func GetCertificateH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_model.CertificatePath, func(gc *gin.Context) {
+		info, err := a.GetCertificate(gc.Request.Context())
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, info)
+	}
+}
+
+// GetCACertificateH
+// @Summary Get CA certificate
+// @Description	Get the PEM encoded certificate of the local CA, clients can add it to their trusted certificates.
+// @Tags Certificates
+// @Produce	plain
+// @Success	200 {string} string "CA certificate"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /certificate/ca [get]
+func GetCACertificateH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(lib_model.CertificatePath, lib_model.CACertificatePath), func(gc *gin.Context) {
+		cert, err := a.GetCACertificate(gc.Request.Context())
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, cert)
+	}
+}
@@ -32,6 +32,10 @@ type endpointFilterQuery struct {
 	Labels string `form:"labels"`
 }
 
+type postEndpointAliasQuery struct {
+	Force bool `form:"force"`
+}
+
 // GetEndpointsH
 // @Summary List endpoints
 // @Description	List HTTP endpoints.
@@ -95,6 +99,7 @@ func GetEndpointH(a lib.Api) (string, string, gin.HandlerFunc) {
 // @Produce	plain
 // @Param id path string true "endpoint id"
 // @Param alias body lib_model.EndpointAliasReq false "endpoint alias information"
+// @Param force query bool false "add alias despite conflicting endpoints"
 // @Success	200 {string} string "job ID"
 // @Failure	400 {string} string "error message"
 // @Failure	404 {string} string "error message"
@@ -109,7 +114,12 @@ func PostEndpointAliasH(a lib.Api) (string, string, gin.HandlerFunc) {
 			_ = gc.Error(lib_model.NewInvalidInputError(err))
 			return
 		}
-		jID, err = a.AddEndpointAlias(gc.Request.Context(), gc.Param("id"), aliasReq.Path)
+		query := postEndpointAliasQuery{}
+		if err = gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		jID, err = a.AddEndpointAliasWithOptions(gc.Request.Context(), gc.Param("id"), aliasReq.Path, lib_model.EndpointOptions{Force: query.Force})
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -17,22 +17,33 @@
 package shared
 
 import (
-	"github.com/SENERGY-Platform/mgw-container-engine-wrapper/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/handler/http_hdl/util"
 	"github.com/SENERGY-Platform/mgw-core-manager/lib"
 	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
 	"github.com/gin-gonic/gin"
 	"io"
 	"net/http"
 	"path"
+	"time"
 )
 
 type logQuery struct {
-	MaxLines int `form:"max_lines"`
+	MaxLines    int    `form:"max_lines"`
+	Follow      bool   `form:"follow"`
+	Since       string `form:"since"`
+	Until       string `form:"until"`
+	Grep        string `form:"grep"`
+	GrepRegex   bool   `form:"grep_regex"`
+	Generations int    `form:"generations"`
+	Level       string `form:"level"`
+	Fields      string `form:"fields"`
+	Filters     string `form:"filters"`
+	Normalize   bool   `form:"normalize"`
 }
 
 // GetLogsH
 // @Summary List logs
-// @Description	List logs of core services not running as containers.
+// @Description	List logs of core services including container logs.
 // @Tags Logs
 // @Produce	json
 // @Success	200 {object} map[string]lib_model.Log "logs"
@@ -53,11 +64,21 @@ func GetLogsH(a lib.Api) (string, string, gin.HandlerFunc) {
 // @Summary Get Log
 // @Description	Get log of a core services.
 // @Tags Logs
-// @Produce	plain
+// @Produce	plain,json
 // @Param id path string true "log id"
 // @Param max_lines query string false "maximum number of lines to return"
+// @Param follow query bool false "keep the connection open and stream new lines as they are appended (file logs only)"
+// @Param since query string false "return lines since timestamp"
+// @Param until query string false "return lines until timestamp"
+// @Param grep query string false "only return lines containing this string"
+// @Param grep_regex query bool false "interpret grep as regular expression"
+// @Param generations query int false "number of rotated log files to include"
+// @Param level query string false "only return entries with this level (json logs)"
+// @Param filters query string false "comma seperated list of field filters (e.g.: key1=val1,key2=val2,...) (json logs)"
+// @Param normalize query bool false "return a json array of normalized entries instead of raw lines"
+// @Param fields query string false "comma seperated list of fields to include in normalized entries"
 // @Success	200 {string} string "log entries"
-// @Failure	400 {string} string "error message"
+// @Failure	400 {string} string "error message, e.g. follow requested for a container log"
 // @Failure	404 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /logs/{id} [get]
@@ -65,10 +86,37 @@ func GetLogH(a lib.Api) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, path.Join(lib_model.LogsPath, ":id"), func(gc *gin.Context) {
 		query := logQuery{}
 		if err := gc.ShouldBindQuery(&query); err != nil {
-			_ = gc.Error(model.NewInvalidInputError(err))
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
 			return
 		}
-		rc, err := a.GetLog(gc.Request.Context(), gc.Param("id"), query.MaxLines)
+		filter := lib_model.LogFilter{
+			MaxLines:    query.MaxLines,
+			Follow:      query.Follow,
+			Grep:        query.Grep,
+			GrepRegex:   query.GrepRegex,
+			Generations: query.Generations,
+			Level:       query.Level,
+			Fields:      util.ParseStringSlice(query.Fields, ","),
+			FieldFilter: util.GenLabels(util.ParseStringSlice(query.Filters, ",")),
+			Normalize:   query.Normalize,
+		}
+		if query.Since != "" {
+			t, err := time.Parse(time.RFC3339Nano, query.Since)
+			if err != nil {
+				_ = gc.Error(lib_model.NewInvalidInputError(err))
+				return
+			}
+			filter.Since = t
+		}
+		if query.Until != "" {
+			t, err := time.Parse(time.RFC3339Nano, query.Until)
+			if err != nil {
+				_ = gc.Error(lib_model.NewInvalidInputError(err))
+				return
+			}
+			filter.Until = t
+		}
+		rc, err := a.GetLogFiltered(gc.Request.Context(), gc.Param("id"), filter)
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -76,7 +124,11 @@ func GetLogH(a lib.Api) (string, string, gin.HandlerFunc) {
 		defer rc.Close()
 		gc.Status(http.StatusOK)
 		gc.Header("Transfer-Encoding", "chunked")
-		gc.Header("Content-Type", gin.MIMEPlain)
+		if filter.Normalize {
+			gc.Header("Content-Type", gin.MIMEJSON)
+		} else {
+			gc.Header("Content-Type", gin.MIMEPlain)
+		}
 		for {
 			var b = make([]byte, 204800)
 			n, rErr := rc.Read(b)
@@ -85,22 +137,44 @@ func GetLogH(a lib.Api) (string, string, gin.HandlerFunc) {
 					if n > 0 {
 						_, wErr := gc.Writer.Write(b[:n])
 						if wErr != nil {
-							_ = gc.Error(model.NewInternalError(wErr))
+							_ = gc.Error(lib_model.NewInternalError(wErr))
 							return
 						}
 						gc.Writer.Flush()
 					}
 					break
 				}
-				_ = gc.Error(model.NewInternalError(rErr))
+				_ = gc.Error(lib_model.NewInternalError(rErr))
 				return
 			}
 			_, wErr := gc.Writer.Write(b[:n])
 			if wErr != nil {
-				_ = gc.Error(model.NewInternalError(wErr))
+				_ = gc.Error(lib_model.NewInternalError(wErr))
 				return
 			}
 			gc.Writer.Flush()
 		}
 	}
 }
+
+// GetLogFilesH
+// @Summary List log files
+// @Description	List the current and rotated files of a log.
+// @Tags Logs
+// @Produce	json
+// @Param id path string true "log id"
+// @Success	200 {array} lib_model.LogFile "log files"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /logs/{id}/files [get]
+func GetLogFilesH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(lib_model.LogsPath, ":id", lib_model.LogFilesPath), func(gc *gin.Context) {
+		files, err := a.ListLogFiles(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, files)
+	}
+}
@@ -25,6 +25,8 @@ var Routes = gin_mw.Routes[lib.Api]{
 	GetEndpointsH,
 	GetEndpointH,
 	PostEndpointAliasH,
+	GetStreamEndpointsH,
+	GetStreamEndpointH,
 	GetCoreServicesH,
 	GetCoreServiceH,
 	PatchRestartCoreServiceH,
@@ -33,5 +35,8 @@ var Routes = gin_mw.Routes[lib.Api]{
 	PatchJobCancelH,
 	GetLogsH,
 	GetLogH,
+	GetLogFilesH,
 	GetSrvInfo,
+	GetCertificateH,
+	GetCACertificateH,
 }
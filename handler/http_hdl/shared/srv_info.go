@@ -19,21 +19,28 @@ package shared
 import (
 	"github.com/SENERGY-Platform/mgw-core-manager/lib"
 	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
-	_ "github.com/SENERGY-Platform/mgw-go-service-base/srv-info-hdl/lib"
 	"github.com/gin-gonic/gin"
 	"net/http"
 )
 
 // GetSrvInfo
 // @Summary Get service info
-// @Description	Get basic service and runtime information.
+// @Description	Get basic service and runtime information as well as warnings (e.g. an expiring certificate).
 // @Tags Info
 // @Produce	json
-// @Success	200 {object} lib.SrvInfo "info"
+// @Success	200 {object} lib_model.SrvInfo "info"
 // @Failure	500 {string} string "error message"
 // @Router /info [get]
 func GetSrvInfo(a lib.Api) (string, string, gin.HandlerFunc) {
 	return http.MethodGet, lib_model.SrvInfoPath, func(gc *gin.Context) {
-		gc.JSON(http.StatusOK, a.GetSrvInfo(gc.Request.Context()))
+		warnings, err := a.GetSrvWarnings(gc.Request.Context())
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, lib_model.SrvInfo{
+			SrvInfo:  a.GetSrvInfo(gc.Request.Context()),
+			Warnings: warnings,
+		})
 	}
 }
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package shared
+
+import (
+	"github.com/SENERGY-Platform/mgw-core-manager/handler/http_hdl/util"
+	"github.com/SENERGY-Platform/mgw-core-manager/lib"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+)
+
+type streamEndpointFilterQuery struct {
+	IDs      string `form:"ids"`
+	Ref      string `form:"ref"`
+	Protocol string `form:"protocol"`
+	Labels   string `form:"labels"`
+}
+
+// GetStreamEndpointsH
+// @Summary List stream endpoints
+// @Description	List TCP/UDP endpoints.
+// @Tags Stream Endpoints
+// @Produce	json
+// @Param ids query string false "comma seperated list of endpoint ids (e.g.: id1,id2,...)"
+// @Param ref query string false "reference value (e.g.: a foreign id)"
+// @Param protocol query string false "tcp or udp"
+// @Param labels query string false "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)"
+// @Success	200 {object} map[string]lib_model.StreamEndpoint "stream endpoints"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /stream-endpoints [get]
+func GetStreamEndpointsH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, lib_model.StreamEndpointsPath, func(gc *gin.Context) {
+		query := streamEndpointFilterQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		endpoints, err := a.GetStreamEndpoints(gc.Request.Context(), lib_model.StreamEndpointFilter{
+			IDs:      util.ParseStringSlice(query.IDs, ","),
+			Ref:      query.Ref,
+			Protocol: query.Protocol,
+			Labels:   util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+		})
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, endpoints)
+	}
+}
+
+// GetStreamEndpointH
+// @Summary Get stream endpoint
+// @Description	Get TCP/UDP endpoint.
+// @Tags Stream Endpoints
+// @Produce	json
+// @Param id path string true "endpoint id"
+// @Success	200 {object} lib_model.StreamEndpoint "stream endpoint"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /stream-endpoints/{id} [get]
+func GetStreamEndpointH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(lib_model.StreamEndpointsPath, ":id"), func(gc *gin.Context) {
+		endpoint, err := a.GetStreamEndpoint(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, endpoint)
+	}
+}
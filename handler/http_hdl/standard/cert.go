@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"github.com/SENERGY-Platform/mgw-core-manager/lib"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+)
+
+// PutCertificateH
+// @Summary Upload certificate
+// @Description	Replace the certificate used by the core reverse proxy. Uploaded certificates are not renewed automatically.
+// @Tags Certificates
+// @Accept json
+// @Produce	plain
+// @Param certificate body lib_model.CertUploadReq true "certificate and key"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /certificate [put]
+func PutCertificateH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPut, lib_model.CertificatePath, func(gc *gin.Context) {
+		var req lib_model.CertUploadReq
+		if err := gc.ShouldBindJSON(&req); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		jID, err := a.UploadCertificate(gc.Request.Context(), req)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// PatchRenewCertificateH
+// @Summary Renew certificate
+// @Description	Replace the certificate used by the core reverse proxy with a new certificate issued by the local CA.
+// @Tags Certificates
+// @Produce	plain
+// @Success	200 {string} string "job ID"
+// @Failure	500 {string} string "error message"
+// @Router /certificate/renew [patch]
+func PatchRenewCertificateH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPatch, path.Join(lib_model.CertificatePath, lib_model.RenewPath), func(gc *gin.Context) {
+		jID, err := a.RenewCertificate(gc.Request.Context())
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
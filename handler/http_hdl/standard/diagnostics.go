@@ -0,0 +1,69 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"github.com/SENERGY-Platform/mgw-core-manager/lib"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+)
+
+// PostDiagnosticsH
+// @Summary Create diagnostics bundle
+// @Description	Create a support bundle containing log tails, core service states, the endpoint config, the sanitized service config and recent jobs. The job result provides the download path.
+// @Tags Diagnostics
+// @Produce	plain
+// @Success	200 {string} string "job ID"
+// @Failure	500 {string} string "error message"
+// @Router /diagnostics [post]
+func PostDiagnosticsH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_model.DiagnosticsPath, func(gc *gin.Context) {
+		jID, err := a.CreateDiagnostics(gc.Request.Context())
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// GetDiagnosticsH
+// @Summary Download diagnostics bundle
+// @Description	Download a diagnostics bundle as tar.gz archive.
+// @Tags Diagnostics
+// @Produce	application/gzip
+// @Param id path string true "bundle id"
+// @Success	200 {file} file "bundle"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /diagnostics/{id} [get]
+func GetDiagnosticsH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(lib_model.DiagnosticsPath, ":id"), func(gc *gin.Context) {
+		rc, err := a.GetDiagnostics(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		defer rc.Close()
+		gc.DataFromReader(http.StatusOK, -1, "application/gzip", rc, map[string]string{
+			"Content-Disposition": "attachment; filename=\"diagnostics_" + gc.Param("id") + ".tar.gz\"",
+		})
+	}
+}
@@ -23,12 +23,25 @@ import (
 	"github.com/gin-gonic/gin"
 	"net/http"
 	"path"
+	"strconv"
 )
 
 type deleteEndpointBatchQuery struct {
 	IDs    string `form:"ids"`
 	Ref    string `form:"ref"`
 	Labels string `form:"labels"`
+	DryRun bool   `form:"dry_run"`
+}
+
+type patchEndpointBatchMaintenanceQuery struct {
+	IDs    string `form:"ids"`
+	Ref    string `form:"ref"`
+	Labels string `form:"labels"`
+}
+
+type postEndpointQuery struct {
+	DryRun bool `form:"dry_run"`
+	Force  bool `form:"force"`
 }
 
 // PostEndpointH
@@ -36,9 +49,11 @@ type deleteEndpointBatchQuery struct {
 // @Description	Create an HTTP endpoint accessible via the core reverse proxy.
 // @Tags HTTP Endpoints
 // @Accept json
-// @Produce	plain
+// @Produce	plain,json
 // @Param endpoint body lib_model.EndpointBase true "endpoint information"
-// @Success	200 {string} string "job ID"
+// @Param dry_run query bool false "only return the changes that would be applied"
+// @Param force query bool false "replace conflicting endpoints"
+// @Success	200 {string} string "job ID or changes if dry run"
 // @Failure	400 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /endpoints [post]
@@ -51,7 +66,21 @@ func PostEndpointH(a lib.Api) (string, string, gin.HandlerFunc) {
 			_ = gc.Error(lib_model.NewInvalidInputError(err))
 			return
 		}
-		jID, err = a.SetEndpoint(gc.Request.Context(), endpointBase)
+		query := postEndpointQuery{}
+		if err = gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		if query.DryRun {
+			changes, err := a.SetEndpointsDryRunWithOptions(gc.Request.Context(), []lib_model.EndpointBase{endpointBase}, lib_model.EndpointOptions{Force: query.Force})
+			if err != nil {
+				_ = gc.Error(err)
+				return
+			}
+			gc.JSON(http.StatusOK, changes)
+			return
+		}
+		jID, err = a.SetEndpointWithOptions(gc.Request.Context(), endpointBase, lib_model.EndpointOptions{Force: query.Force})
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -85,9 +114,11 @@ func DeleteEndpointH(a lib.Api) (string, string, gin.HandlerFunc) {
 // @Description	Create multiple HTTP endpoints accessible via the core reverse proxy.
 // @Tags HTTP Endpoints
 // @Accept json
-// @Produce	plain
+// @Produce	plain,json
 // @Param endpoints body []lib_model.EndpointBase true "list of endpoint information items"
-// @Success	200 {string} string "job ID"
+// @Param dry_run query bool false "only return the changes that would be applied"
+// @Param force query bool false "replace conflicting endpoints"
+// @Success	200 {string} string "job ID or changes if dry run"
 // @Failure	400 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /endpoints-batch [post]
@@ -98,7 +129,21 @@ func PostEndpointBatchH(a lib.Api) (string, string, gin.HandlerFunc) {
 			_ = gc.Error(lib_model.NewInvalidInputError(err))
 			return
 		}
-		jID, err := a.SetEndpoints(gc.Request.Context(), endpointBaseSl)
+		query := postEndpointQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		if query.DryRun {
+			changes, err := a.SetEndpointsDryRunWithOptions(gc.Request.Context(), endpointBaseSl, lib_model.EndpointOptions{Force: query.Force})
+			if err != nil {
+				_ = gc.Error(err)
+				return
+			}
+			gc.JSON(http.StatusOK, changes)
+			return
+		}
+		jID, err := a.SetEndpointsWithOptions(gc.Request.Context(), endpointBaseSl, lib_model.EndpointOptions{Force: query.Force})
 		if err != nil {
 			_ = gc.Error(err)
 			return
@@ -111,11 +156,12 @@ func PostEndpointBatchH(a lib.Api) (string, string, gin.HandlerFunc) {
 // @Summary Delete endpoints
 // @Description	Remove multiple HTTP endpoints.
 // @Tags HTTP Endpoints
-// @Produce	plain
+// @Produce	plain,json
 // @Param ids query string false "comma seperated list of endpoint ids (e.g.: id1,id2,...)"
 // @Param ref query string false "reference value (e.g.: a foreign id)"
 // @Param labels query string false "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)"
-// @Success	200 {string} string "job ID"
+// @Param dry_run query bool false "only return the changes that would be applied"
+// @Success	200 {string} string "job ID or changes if dry run"
 // @Failure	400 {string} string "error message"
 // @Failure	500 {string} string "error message"
 // @Router /endpoints-batch [delete]
@@ -126,11 +172,136 @@ func DeleteEndpointBatchH(a lib.Api) (string, string, gin.HandlerFunc) {
 			_ = gc.Error(lib_model.NewInvalidInputError(err))
 			return
 		}
-		jID, err := a.RemoveEndpoints(gc.Request.Context(), lib_model.EndpointFilter{
+		filter := lib_model.EndpointFilter{
 			IDs:    util.ParseStringSlice(query.IDs, ","),
 			Ref:    query.Ref,
 			Labels: util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
-		}, false)
+		}
+		if query.DryRun {
+			changes, err := a.RemoveEndpointsDryRun(gc.Request.Context(), filter, false)
+			if err != nil {
+				_ = gc.Error(err)
+				return
+			}
+			gc.JSON(http.StatusOK, changes)
+			return
+		}
+		jID, err := a.RemoveEndpoints(gc.Request.Context(), filter, false)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// PatchEndpointMaintenanceH
+// @Summary Set endpoint maintenance mode
+// @Description	Enable or disable maintenance mode for an HTTP endpoint and its aliases. Requests are answered with 503 and the maintenance page if configured.
+// @Tags HTTP Endpoints
+// @Accept json
+// @Produce	plain
+// @Param id path string true "endpoint id"
+// @Param maintenance body lib_model.EndpointMaintenance true "maintenance mode"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /endpoints/{id}/maintenance [patch]
+func PatchEndpointMaintenanceH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPatch, path.Join(lib_model.EndpointsPath, ":id", lib_model.MaintenancePath), func(gc *gin.Context) {
+		var maintenance lib_model.EndpointMaintenance
+		if err := gc.ShouldBindJSON(&maintenance); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		jID, err := a.SetEndpointMaintenance(gc.Request.Context(), gc.Param("id"), maintenance)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// PatchEndpointBatchMaintenanceH
+// @Summary Set endpoints maintenance mode
+// @Description	Enable or disable maintenance mode for multiple HTTP endpoints and their aliases. Redirect and static response endpoints are ignored.
+// @Tags HTTP Endpoints
+// @Accept json
+// @Produce	plain
+// @Param ids query string false "comma seperated list of endpoint ids (e.g.: id1,id2,...)"
+// @Param ref query string false "reference value (e.g.: a foreign id)"
+// @Param labels query string false "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)"
+// @Param maintenance body lib_model.EndpointMaintenance true "maintenance mode"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /endpoints-batch/maintenance [patch]
+func PatchEndpointBatchMaintenanceH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPatch, path.Join(lib_model.EndpointsBatchPath, lib_model.MaintenancePath), func(gc *gin.Context) {
+		var maintenance lib_model.EndpointMaintenance
+		if err := gc.ShouldBindJSON(&maintenance); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		query := patchEndpointBatchMaintenanceQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		filter := lib_model.EndpointFilter{
+			IDs:    util.ParseStringSlice(query.IDs, ","),
+			Ref:    query.Ref,
+			Labels: util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+		}
+		jID, err := a.SetEndpointsMaintenance(gc.Request.Context(), filter, maintenance)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// GetEndpointHistoryH
+// @Summary List endpoint config versions
+// @Description	List stored versions of the endpoint config, newest first.
+// @Tags HTTP Endpoints
+// @Produce	json
+// @Success	200 {array} lib_model.EndpointConfigVersion "config versions"
+// @Failure	500 {string} string "error message"
+// @Router /endpoints/history [get]
+func GetEndpointHistoryH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodGet, path.Join(lib_model.EndpointsPath, lib_model.HistoryPath), func(gc *gin.Context) {
+		history, err := a.GetEndpointHistory(gc.Request.Context())
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.JSON(http.StatusOK, history)
+	}
+}
+
+// PostEndpointHistoryRestoreH
+// @Summary Restore endpoint config
+// @Description	Restore the endpoints of a stored config version. Stream endpoints are not versioned and remain unchanged.
+// @Tags HTTP Endpoints
+// @Produce	plain
+// @Param version path int true "config version"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /endpoints/history/{version}/restore [post]
+func PostEndpointHistoryRestoreH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, path.Join(lib_model.EndpointsPath, lib_model.HistoryPath, ":version", lib_model.RestorePath), func(gc *gin.Context) {
+		version, err := strconv.Atoi(gc.Param("version"))
+		if err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		jID, err := a.RestoreEndpoints(gc.Request.Context(), version)
 		if err != nil {
 			_ = gc.Error(err)
 			return
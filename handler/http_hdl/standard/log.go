@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"github.com/SENERGY-Platform/mgw-core-manager/lib"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+)
+
+// PostLogH
+// @Summary Register log
+// @Description	Register a host log file located in an allowed directory.
+// @Tags Logs
+// @Accept json
+// @Produce	plain
+// @Param log body lib_model.LogReq true "log information"
+// @Success	200 {string} string "log ID"
+// @Failure	400 {string} string "error message"
+// @Failure	403 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /logs [post]
+func PostLogH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_model.LogsPath, func(gc *gin.Context) {
+		var logReq lib_model.LogReq
+		if err := gc.ShouldBindJSON(&logReq); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		id, err := a.AddLog(gc.Request.Context(), logReq)
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, id)
+	}
+}
+
+// DeleteLogH
+// @Summary Delete log
+// @Description	Remove a registered log.
+// @Tags Logs
+// @Param id path string true "log id"
+// @Success	200
+// @Failure	403 {string} string "error message"
+// @Failure	404 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /logs/{id} [delete]
+func DeleteLogH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, path.Join(lib_model.LogsPath, ":id"), func(gc *gin.Context) {
+		err := a.RemoveLog(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.Status(http.StatusOK)
+	}
+}
@@ -32,7 +32,20 @@ var routes = gin_mw.Routes[lib.Api]{
 	DeleteEndpointH,
 	PostEndpointBatchH,
 	DeleteEndpointBatchH,
+	PatchEndpointMaintenanceH,
+	PatchEndpointBatchMaintenanceH,
+	GetEndpointHistoryH,
+	PostEndpointHistoryRestoreH,
+	PostStreamEndpointH,
+	DeleteStreamEndpointH,
+	DeleteStreamEndpointBatchH,
 	PatchPurgeImagesH,
+	PostLogH,
+	DeleteLogH,
+	PostDiagnosticsH,
+	GetDiagnosticsH,
+	PutCertificateH,
+	PatchRenewCertificateH,
 }
 
 // SetRoutes
@@ -0,0 +1,124 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package standard
+
+import (
+	"github.com/SENERGY-Platform/mgw-core-manager/handler/http_hdl/util"
+	"github.com/SENERGY-Platform/mgw-core-manager/lib"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/gin-gonic/gin"
+	"net/http"
+	"path"
+)
+
+type postStreamEndpointQuery struct {
+	Force bool `form:"force"`
+}
+
+type deleteStreamEndpointBatchQuery struct {
+	IDs      string `form:"ids"`
+	Ref      string `form:"ref"`
+	Protocol string `form:"protocol"`
+	Labels   string `form:"labels"`
+}
+
+// PostStreamEndpointH
+// @Summary Create stream endpoint
+// @Description	Create a TCP/UDP endpoint listening on an external port of the core reverse proxy. If no external port is provided, a free port is allocated.
+// @Tags Stream Endpoints
+// @Accept json
+// @Produce	plain
+// @Param endpoint body lib_model.StreamEndpointBase true "stream endpoint information"
+// @Param force query bool false "replace a stream endpoint of a different reference or target using the same port"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /stream-endpoints [post]
+func PostStreamEndpointH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodPost, lib_model.StreamEndpointsPath, func(gc *gin.Context) {
+		var endpointBase lib_model.StreamEndpointBase
+		if err := gc.ShouldBindJSON(&endpointBase); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		query := postStreamEndpointQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		jID, err := a.SetStreamEndpointWithOptions(gc.Request.Context(), endpointBase, lib_model.StreamEndpointOptions{Force: query.Force})
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// DeleteStreamEndpointH
+// @Summary Delete stream endpoint
+// @Description	Remove a TCP/UDP endpoint.
+// @Tags Stream Endpoints
+// @Produce	plain
+// @Param id path string true "endpoint id"
+// @Success	200 {string} string "job ID"
+// @Failure	500 {string} string "error message"
+// @Router /stream-endpoints/{id} [delete]
+func DeleteStreamEndpointH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, path.Join(lib_model.StreamEndpointsPath, ":id"), func(gc *gin.Context) {
+		jID, err := a.RemoveStreamEndpoint(gc.Request.Context(), gc.Param("id"))
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
+
+// DeleteStreamEndpointBatchH
+// @Summary Delete stream endpoints
+// @Description	Remove multiple TCP/UDP endpoints.
+// @Tags Stream Endpoints
+// @Produce	plain
+// @Param ids query string false "comma seperated list of endpoint ids (e.g.: id1,id2,...)"
+// @Param ref query string false "reference value (e.g.: a foreign id)"
+// @Param protocol query string false "tcp or udp"
+// @Param labels query string false "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)"
+// @Success	200 {string} string "job ID"
+// @Failure	400 {string} string "error message"
+// @Failure	500 {string} string "error message"
+// @Router /stream-endpoints-batch [delete]
+func DeleteStreamEndpointBatchH(a lib.Api) (string, string, gin.HandlerFunc) {
+	return http.MethodDelete, lib_model.StreamEndpointsBatchPath, func(gc *gin.Context) {
+		query := deleteStreamEndpointBatchQuery{}
+		if err := gc.ShouldBindQuery(&query); err != nil {
+			_ = gc.Error(lib_model.NewInvalidInputError(err))
+			return
+		}
+		jID, err := a.RemoveStreamEndpoints(gc.Request.Context(), lib_model.StreamEndpointFilter{
+			IDs:      util.ParseStringSlice(query.IDs, ","),
+			Ref:      query.Ref,
+			Protocol: query.Protocol,
+			Labels:   util.GenLabels(util.ParseStringSlice(query.Labels, ",")),
+		})
+		if err != nil {
+			_ = gc.Error(err)
+			return
+		}
+		gc.String(http.StatusOK, jID)
+	}
+}
@@ -19,6 +19,70 @@ const docTemplaterestricted = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/certificate": {
+            "get": {
+                "description": "Get information about the certificate used by the core reverse proxy.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Get certificate",
+                "responses": {
+                    "200": {
+                        "description": "certificate information",
+                        "schema": {
+                            "$ref": "#/definitions/model.CertInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificate/ca": {
+            "get": {
+                "description": "Get the PEM encoded certificate of the local CA, clients can add it to their trusted certificates.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Get CA certificate",
+                "responses": {
+                    "200": {
+                        "description": "CA certificate",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/core-services": {
             "get": {
                 "description": "List core services including image and container information.",
@@ -277,6 +341,12 @@ const docTemplaterestricted = `{
                         "schema": {
                             "$ref": "#/definitions/model.EndpointAliasReq"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "add alias despite conflicting endpoints",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -309,7 +379,7 @@ const docTemplaterestricted = `{
         },
         "/info": {
             "get": {
-                "description": "Get basic service and runtime information.",
+                "description": "Get basic service and runtime information as well as warnings (e.g. an expiring certificate).",
                 "produces": [
                     "application/json"
                 ],
@@ -321,7 +391,7 @@ const docTemplaterestricted = `{
                     "200": {
                         "description": "info",
                         "schema": {
-                            "$ref": "#/definitions/lib.SrvInfo"
+                            "$ref": "#/definitions/model.SrvInfo"
                         }
                     },
                     "500": {
@@ -472,7 +542,7 @@ const docTemplaterestricted = `{
         },
         "/logs": {
             "get": {
-                "description": "List logs of core services not running as containers.",
+                "description": "List logs of core services including container logs.",
                 "produces": [
                     "application/json"
                 ],
@@ -503,7 +573,8 @@ const docTemplaterestricted = `{
             "get": {
                 "description": "Get log of a core services.",
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/json"
                 ],
                 "tags": [
                     "Logs"
@@ -522,6 +593,66 @@ const docTemplaterestricted = `{
                         "description": "maximum number of lines to return",
                         "name": "max_lines",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "keep the connection open and stream new lines as they are appended (file logs only)",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "return lines since timestamp",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "return lines until timestamp",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return lines containing this string",
+                        "name": "grep",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "interpret grep as regular expression",
+                        "name": "grep_regex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of rotated log files to include",
+                        "name": "generations",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return entries with this level (json logs)",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of field filters (e.g.: key1=val1,key2=val2,...) (json logs)",
+                        "name": "filters",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return a json array of normalized entries instead of raw lines",
+                        "name": "normalize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of fields to include in normalized entries",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -531,6 +662,56 @@ const docTemplaterestricted = `{
                             "type": "string"
                         }
                     },
+                    "400": {
+                        "description": "error message, e.g. follow requested for a container log",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/logs/{id}/files": {
+            "get": {
+                "description": "List the current and rotated files of a log.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Logs"
+                ],
+                "summary": "List log files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "log id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "log files",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.LogFile"
+                            }
+                        }
+                    },
                     "400": {
                         "description": "error message",
                         "schema": {
@@ -551,20 +732,111 @@ const docTemplaterestricted = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_SENERGY-Platform_mgw-core-manager_lib_model.Image": {
-            "type": "object",
-            "properties": {
-                "repository": {
-                    "type": "string"
-                },
-                "tag": {
-                    "type": "string"
+        },
+        "/stream-endpoints": {
+            "get": {
+                "description": "List TCP/UDP endpoints.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "List stream endpoints",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of endpoint ids (e.g.: id1,id2,...)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reference value (e.g.: a foreign id)",
+                        "name": "ref",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "tcp or udp",
+                        "name": "protocol",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)",
+                        "name": "labels",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream endpoints",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/model.StreamEndpoint"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 }
             }
         },
+        "/stream-endpoints/{id}": {
+            "get": {
+                "description": "Get TCP/UDP endpoint.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "Get stream endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream endpoint",
+                        "schema": {
+                            "$ref": "#/definitions/model.StreamEndpoint"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
         "lib.Job": {
             "type": "object",
             "properties": {
@@ -620,19 +892,65 @@ const docTemplaterestricted = `{
                 }
             }
         },
-        "lib.SrvInfo": {
+        "model.AuthConfig": {
             "type": "object",
             "properties": {
-                "mem_stats": {
-                    "$ref": "#/definitions/lib.MemStats"
+                "allow": {
+                    "description": "addresses or CIDRs, if set all other addresses are denied",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
+                "auth_request": {
+                    "description": "authorize requests via the configured auth service",
+                    "type": "boolean"
+                },
+                "basic_auth": {
+                    "description": "user:password, passwords are stored hashed and omitted in responses, empty password -\u003e keep current password",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "deny": {
+                    "description": "addresses or CIDRs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.CertInfo": {
+            "type": "object",
+            "properties": {
+                "dns_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "generated": {
+                    "description": "issued by the local CA and renewed automatically",
+                    "type": "boolean"
+                },
+                "ips": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "issuer": {
                     "type": "string"
                 },
-                "up_time": {
-                    "$ref": "#/definitions/time.Duration"
+                "not_after": {
+                    "type": "string"
                 },
-                "version": {
+                "not_before": {
+                    "type": "string"
+                },
+                "subject": {
                     "type": "string"
                 }
             }
@@ -644,7 +962,7 @@ const docTemplaterestricted = `{
                     "$ref": "#/definitions/model.SrvContainer"
                 },
                 "image": {
-                    "$ref": "#/definitions/github_com_SENERGY-Platform_mgw-core-manager_lib_model.Image"
+                    "$ref": "#/definitions/model.Image"
                 },
                 "name": {
                     "type": "string"
@@ -654,9 +972,15 @@ const docTemplaterestricted = `{
         "model.Endpoint": {
             "type": "object",
             "properties": {
+                "auth": {
+                    "$ref": "#/definitions/model.AuthConfig"
+                },
                 "ext_path": {
                     "type": "string"
                 },
+                "health_check": {
+                    "$ref": "#/definitions/model.HealthCheck"
+                },
                 "host": {
                     "type": "string"
                 },
@@ -675,6 +999,14 @@ const docTemplaterestricted = `{
                 "location": {
                     "type": "string"
                 },
+                "maintenance": {
+                    "description": "set while the endpoint is in maintenance mode",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.EndpointMaintenance"
+                        }
+                    ]
+                },
                 "parent_id": {
                     "type": "string"
                 },
@@ -684,14 +1016,45 @@ const docTemplaterestricted = `{
                 "proxy_conf": {
                     "$ref": "#/definitions/model.ProxyConfig"
                 },
+                "redirect": {
+                    "description": "respond with a redirect instead of proxying requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Redirect"
+                        }
+                    ]
+                },
                 "ref": {
                     "type": "string"
                 },
+                "static": {
+                    "description": "respond with a static response instead of proxying requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.StaticResponse"
+                        }
+                    ]
+                },
+                "status": {
+                    "type": "string"
+                },
                 "string_sub": {
                     "$ref": "#/definitions/model.StringSub"
                 },
+                "subdomain": {
+                    "description": "route requests by host header via a dedicated server block instead of a path prefix",
+                    "type": "boolean"
+                },
                 "type": {
                     "$ref": "#/definitions/model.EndpointType"
+                },
+                "upstream": {
+                    "description": "load balance requests across multiple targets instead of host and port",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Upstream"
+                        }
+                    ]
                 }
             }
         },
@@ -703,19 +1066,75 @@ const docTemplaterestricted = `{
                 }
             }
         },
+        "model.EndpointMaintenance": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "retry_after": {
+                    "description": "value of the Retry-After header, 0 -\u003e header omitted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/time.Duration"
+                        }
+                    ]
+                }
+            }
+        },
         "model.EndpointType": {
             "type": "integer",
             "enum": [
                 1,
                 2,
-                3
+                3,
+                4,
+                5,
+                6,
+                7
             ],
             "x-enum-varnames": [
                 "StandardEndpoint",
                 "AliasEndpoint",
-                "DefaultGuiEndpoint"
+                "DefaultGuiEndpoint",
+                "SubdomainEndpoint",
+                "SubdomainAliasEndpoint",
+                "RedirectEndpoint",
+                "StaticEndpoint"
             ]
         },
+        "model.HealthCheck": {
+            "type": "object",
+            "properties": {
+                "expected_status": {
+                    "description": "0 -\u003e any 2xx or 3xx status",
+                    "type": "integer"
+                },
+                "interval": {
+                    "description": "0 -\u003e default interval",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/time.Duration"
+                        }
+                    ]
+                },
+                "path": {
+                    "description": "request path on the target, empty -\u003e int_path",
+                    "type": "string"
+                }
+            }
+        },
+        "model.Image": {
+            "type": "object",
+            "properties": {
+                "repository": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
         "model.Log": {
             "type": "object",
             "properties": {
@@ -727,23 +1146,97 @@ const docTemplaterestricted = `{
                 }
             }
         },
+        "model.LogFile": {
+            "type": "object",
+            "properties": {
+                "compressed": {
+                    "type": "boolean"
+                },
+                "mod_time": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
         "model.ProxyConfig": {
             "type": "object",
             "properties": {
+                "buffering": {
+                    "description": "nil -\u003e nginx default, disable for server-sent events",
+                    "type": "boolean"
+                },
+                "client_max_body_size": {
+                    "description": "nginx size value (e.g. 10m), 0 -\u003e unlimited, empty -\u003e nginx default",
+                    "type": "string"
+                },
+                "conn_limit": {
+                    "description": "concurrent connections per client, 0 -\u003e unlimited",
+                    "type": "integer"
+                },
+                "connect_timeout": {
+                    "$ref": "#/definitions/time.Duration"
+                },
                 "headers": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
+                "hide_headers": {
+                    "description": "removed from upstream responses",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "read_timeout": {
                     "$ref": "#/definitions/time.Duration"
                 },
+                "req_burst": {
+                    "description": "requests exceeding the rate that are delayed before rejecting",
+                    "type": "integer"
+                },
+                "req_rate": {
+                    "description": "requests per second and client, 0 -\u003e unlimited",
+                    "type": "integer"
+                },
+                "request_buffering": {
+                    "description": "nil -\u003e nginx default",
+                    "type": "boolean"
+                },
+                "response_headers": {
+                    "description": "added to responses",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "send_timeout": {
+                    "$ref": "#/definitions/time.Duration"
+                },
                 "websocket": {
                     "type": "boolean"
                 }
             }
         },
+        "model.Redirect": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "301, 302 or 307, 0 -\u003e 302",
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "absolute URL or path, nginx variables like $request_uri can be used",
+                    "type": "string"
+                }
+            }
+        },
         "model.SrvContainer": {
             "type": "object",
             "properties": {
@@ -758,6 +1251,80 @@ const docTemplaterestricted = `{
                 }
             }
         },
+        "model.SrvInfo": {
+            "type": "object",
+            "properties": {
+                "mem_stats": {
+                    "$ref": "#/definitions/lib.MemStats"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "up_time": {
+                    "$ref": "#/definitions/time.Duration"
+                },
+                "version": {
+                    "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.StaticResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "content_type": {
+                    "description": "empty -\u003e text/plain",
+                    "type": "string"
+                },
+                "file": {
+                    "description": "path relative to the configured static files directory, used instead of body",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "0 -\u003e 200",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StreamEndpoint": {
+            "type": "object",
+            "properties": {
+                "ext_port": {
+                    "description": "0 -\u003e allocate free port from configured range",
+                    "type": "integer"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "tcp or udp, empty -\u003e tcp",
+                    "type": "string"
+                },
+                "ref": {
+                    "type": "string"
+                }
+            }
+        },
         "model.StringSub": {
             "type": "object",
             "properties": {
@@ -781,6 +1348,40 @@ const docTemplaterestricted = `{
                 }
             }
         },
+        "model.Upstream": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "description": "round_robin, least_conn or ip_hash, empty -\u003e round_robin",
+                    "type": "string"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UpstreamTarget"
+                    }
+                }
+            }
+        },
+        "model.UpstreamTarget": {
+            "type": "object",
+            "properties": {
+                "backup": {
+                    "description": "only receives requests if all other targets are unavailable",
+                    "type": "boolean"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "description": "0 -\u003e nginx default",
+                    "type": "integer"
+                }
+            }
+        },
         "time.Duration": {
             "type": "integer",
             "enum": [
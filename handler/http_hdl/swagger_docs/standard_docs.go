@@ -19,6 +19,140 @@ const docTemplatestandard = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/certificate": {
+            "get": {
+                "description": "Get information about the certificate used by the core reverse proxy.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Get certificate",
+                "responses": {
+                    "200": {
+                        "description": "certificate information",
+                        "schema": {
+                            "$ref": "#/definitions/model.CertInfo"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the certificate used by the core reverse proxy. Uploaded certificates are not renewed automatically.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Upload certificate",
+                "parameters": [
+                    {
+                        "description": "certificate and key",
+                        "name": "certificate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.CertUploadReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificate/ca": {
+            "get": {
+                "description": "Get the PEM encoded certificate of the local CA, clients can add it to their trusted certificates.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Get CA certificate",
+                "responses": {
+                    "200": {
+                        "description": "CA certificate",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/certificate/renew": {
+            "patch": {
+                "description": "Replace the certificate used by the core reverse proxy with a new certificate issued by the local CA.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Certificates"
+                ],
+                "summary": "Renew certificate",
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/cleanup/images": {
             "patch": {
                 "description": "Purge unused images of a repository.",
@@ -165,6 +299,79 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "/diagnostics": {
+            "post": {
+                "description": "Create a support bundle containing log tails, core service states, the endpoint config, the sanitized service config and recent jobs. The job result provides the download path.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Diagnostics"
+                ],
+                "summary": "Create diagnostics bundle",
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/diagnostics/{id}": {
+            "get": {
+                "description": "Download a diagnostics bundle as tar.gz archive.",
+                "produces": [
+                    "application/gzip"
+                ],
+                "tags": [
+                    "Diagnostics"
+                ],
+                "summary": "Download diagnostics bundle",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "bundle id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "bundle",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/endpoints": {
             "get": {
                 "description": "Get HTTP endpoint.",
@@ -214,7 +421,8 @@ const docTemplatestandard = `{
                     "application/json"
                 ],
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/json"
                 ],
                 "tags": [
                     "HTTP Endpoints"
@@ -229,11 +437,23 @@ const docTemplatestandard = `{
                         "schema": {
                             "$ref": "#/definitions/model.EndpointBase"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only return the changes that would be applied",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "replace conflicting endpoints",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "job ID",
+                        "description": "job ID or changes if dry run",
                         "schema": {
                             "type": "string"
                         }
@@ -260,7 +480,8 @@ const docTemplatestandard = `{
                     "application/json"
                 ],
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/json"
                 ],
                 "tags": [
                     "HTTP Endpoints"
@@ -278,11 +499,23 @@ const docTemplatestandard = `{
                                 "$ref": "#/definitions/model.EndpointBase"
                             }
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only return the changes that would be applied",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "replace conflicting endpoints",
+                        "name": "force",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "job ID",
+                        "description": "job ID or changes if dry run",
                         "schema": {
                             "type": "string"
                         }
@@ -304,7 +537,8 @@ const docTemplatestandard = `{
             "delete": {
                 "description": "Remove multiple HTTP endpoints.",
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/json"
                 ],
                 "tags": [
                     "HTTP Endpoints"
@@ -328,11 +562,17 @@ const docTemplatestandard = `{
                         "description": "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)",
                         "name": "labels",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "only return the changes that would be applied",
+                        "name": "dry_run",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "job ID",
+                        "description": "job ID or changes if dry run",
                         "schema": {
                             "type": "string"
                         }
@@ -352,23 +592,46 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/endpoints/{id}": {
-            "delete": {
-                "description": "Remove an HTTP endpoint.",
+        "/endpoints-batch/maintenance": {
+            "patch": {
+                "description": "Enable or disable maintenance mode for multiple HTTP endpoints and their aliases. Redirect and static response endpoints are ignored.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "text/plain"
                 ],
                 "tags": [
                     "HTTP Endpoints"
                 ],
-                "summary": "Delete endpoint",
+                "summary": "Set endpoints maintenance mode",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "endpoint id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "comma seperated list of endpoint ids (e.g.: id1,id2,...)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reference value (e.g.: a foreign id)",
+                        "name": "ref",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)",
+                        "name": "labels",
+                        "in": "query"
+                    },
+                    {
+                        "description": "maintenance mode",
+                        "name": "maintenance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.EndpointMaintenance"
+                        }
                     }
                 ],
                 "responses": {
@@ -378,6 +641,12 @@ const docTemplatestandard = `{
                             "type": "string"
                         }
                     },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "error message",
                         "schema": {
@@ -387,24 +656,135 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "/endpoints/{id}/alias": {
-            "post": {
-                "description": "Create an endpoint alias.",
-                "consumes": [
-                    "application/json"
-                ],
+        "/endpoints/history": {
+            "get": {
+                "description": "List stored versions of the endpoint config, newest first.",
                 "produces": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
                     "HTTP Endpoints"
                 ],
-                "summary": "Create endpoint alias",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "endpoint id",
-                        "name": "id",
+                "summary": "List endpoint config versions",
+                "responses": {
+                    "200": {
+                        "description": "config versions",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.EndpointConfigVersion"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/endpoints/history/{version}/restore": {
+            "post": {
+                "description": "Restore the endpoints of a stored config version. Stream endpoints are not versioned and remain unchanged.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "HTTP Endpoints"
+                ],
+                "summary": "Restore endpoint config",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "config version",
+                        "name": "version",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/endpoints/{id}": {
+            "delete": {
+                "description": "Remove an HTTP endpoint.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "HTTP Endpoints"
+                ],
+                "summary": "Delete endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/endpoints/{id}/alias": {
+            "post": {
+                "description": "Create an endpoint alias.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "HTTP Endpoints"
+                ],
+                "summary": "Create endpoint alias",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "endpoint id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
@@ -415,6 +795,71 @@ const docTemplatestandard = `{
                         "schema": {
                             "$ref": "#/definitions/model.EndpointAliasReq"
                         }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "add alias despite conflicting endpoints",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/endpoints/{id}/maintenance": {
+            "patch": {
+                "description": "Enable or disable maintenance mode for an HTTP endpoint and its aliases. Requests are answered with 503 and the maintenance page if configured.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "HTTP Endpoints"
+                ],
+                "summary": "Set endpoint maintenance mode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "maintenance mode",
+                        "name": "maintenance",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.EndpointMaintenance"
+                        }
                     }
                 ],
                 "responses": {
@@ -447,7 +892,7 @@ const docTemplatestandard = `{
         },
         "/info": {
             "get": {
-                "description": "Get basic service and runtime information.",
+                "description": "Get basic service and runtime information as well as warnings (e.g. an expiring certificate).",
                 "produces": [
                     "application/json"
                 ],
@@ -459,7 +904,7 @@ const docTemplatestandard = `{
                     "200": {
                         "description": "info",
                         "schema": {
-                            "$ref": "#/definitions/lib.SrvInfo"
+                            "$ref": "#/definitions/model.SrvInfo"
                         }
                     },
                     "500": {
@@ -610,7 +1055,7 @@ const docTemplatestandard = `{
         },
         "/logs": {
             "get": {
-                "description": "List logs of core services not running as containers.",
+                "description": "List logs of core services including container logs.",
                 "produces": [
                     "application/json"
                 ],
@@ -635,13 +1080,64 @@ const docTemplatestandard = `{
                         }
                     }
                 }
+            },
+            "post": {
+                "description": "Register a host log file located in an allowed directory.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Logs"
+                ],
+                "summary": "Register log",
+                "parameters": [
+                    {
+                        "description": "log information",
+                        "name": "log",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.LogReq"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "log ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
             }
         },
         "/logs/{id}": {
             "get": {
                 "description": "Get log of a core services.",
                 "produces": [
-                    "text/plain"
+                    "text/plain",
+                    "application/json"
                 ],
                 "tags": [
                     "Logs"
@@ -660,6 +1156,66 @@ const docTemplatestandard = `{
                         "description": "maximum number of lines to return",
                         "name": "max_lines",
                         "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "keep the connection open and stream new lines as they are appended (file logs only)",
+                        "name": "follow",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "return lines since timestamp",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "return lines until timestamp",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return lines containing this string",
+                        "name": "grep",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "interpret grep as regular expression",
+                        "name": "grep_regex",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "number of rotated log files to include",
+                        "name": "generations",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "only return entries with this level (json logs)",
+                        "name": "level",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of field filters (e.g.: key1=val1,key2=val2,...) (json logs)",
+                        "name": "filters",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "return a json array of normalized entries instead of raw lines",
+                        "name": "normalize",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of fields to include in normalized entries",
+                        "name": "fields",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -670,7 +1226,7 @@ const docTemplatestandard = `{
                         }
                     },
                     "400": {
-                        "description": "error message",
+                        "description": "error message, e.g. follow requested for a container log",
                         "schema": {
                             "type": "string"
                         }
@@ -688,21 +1244,342 @@ const docTemplatestandard = `{
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "github_com_SENERGY-Platform_mgw-core-manager_lib_model.Image": {
-            "type": "object",
-            "properties": {
-                "repository": {
-                    "type": "string"
-                },
-                "tag": {
-                    "type": "string"
+            },
+            "delete": {
+                "description": "Remove a registered log.",
+                "tags": [
+                    "Logs"
+                ],
+                "summary": "Delete log",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "log id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/logs/{id}/files": {
+            "get": {
+                "description": "List the current and rotated files of a log.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Logs"
+                ],
+                "summary": "List log files",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "log id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "log files",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.LogFile"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream-endpoints": {
+            "get": {
+                "description": "List TCP/UDP endpoints.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "List stream endpoints",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of endpoint ids (e.g.: id1,id2,...)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reference value (e.g.: a foreign id)",
+                        "name": "ref",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "tcp or udp",
+                        "name": "protocol",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)",
+                        "name": "labels",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream endpoints",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/model.StreamEndpoint"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a TCP/UDP endpoint listening on an external port of the core reverse proxy. If no external port is provided, a free port is allocated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "Create stream endpoint",
+                "parameters": [
+                    {
+                        "description": "stream endpoint information",
+                        "name": "endpoint",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/model.StreamEndpointBase"
+                        }
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "replace a stream endpoint of a different reference or target using the same port",
+                        "name": "force",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/stream-endpoints-batch": {
+            "delete": {
+                "description": "Remove multiple TCP/UDP endpoints.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "Delete stream endpoints",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of endpoint ids (e.g.: id1,id2,...)",
+                        "name": "ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "reference value (e.g.: a foreign id)",
+                        "name": "ref",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "tcp or udp",
+                        "name": "protocol",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma seperated list of labels (e.g.: key1=val1,key2=val2,...)",
+                        "name": "labels",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
                 }
             }
         },
+        "/stream-endpoints/{id}": {
+            "get": {
+                "description": "Get TCP/UDP endpoint.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "Get stream endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "stream endpoint",
+                        "schema": {
+                            "$ref": "#/definitions/model.StreamEndpoint"
+                        }
+                    },
+                    "404": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Remove a TCP/UDP endpoint.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Stream Endpoints"
+                ],
+                "summary": "Delete stream endpoint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "endpoint id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "job ID",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "error message",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
         "lib.Job": {
             "type": "object",
             "properties": {
@@ -758,19 +1635,78 @@ const docTemplatestandard = `{
                 }
             }
         },
-        "lib.SrvInfo": {
+        "model.AuthConfig": {
             "type": "object",
             "properties": {
-                "mem_stats": {
-                    "$ref": "#/definitions/lib.MemStats"
+                "allow": {
+                    "description": "addresses or CIDRs, if set all other addresses are denied",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "name": {
+                "auth_request": {
+                    "description": "authorize requests via the configured auth service",
+                    "type": "boolean"
+                },
+                "basic_auth": {
+                    "description": "user:password, passwords are stored hashed and omitted in responses, empty password -\u003e keep current password",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "deny": {
+                    "description": "addresses or CIDRs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.CertInfo": {
+            "type": "object",
+            "properties": {
+                "dns_names": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "generated": {
+                    "description": "issued by the local CA and renewed automatically",
+                    "type": "boolean"
+                },
+                "ips": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "issuer": {
+                    "type": "string"
+                },
+                "not_after": {
+                    "type": "string"
+                },
+                "not_before": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.CertUploadReq": {
+            "type": "object",
+            "properties": {
+                "cert": {
+                    "description": "PEM encoded certificate, optionally followed by intermediate certificates",
                     "type": "string"
                 },
-                "up_time": {
-                    "$ref": "#/definitions/time.Duration"
-                },
-                "version": {
+                "key": {
+                    "description": "PEM encoded private key",
                     "type": "string"
                 }
             }
@@ -782,7 +1718,7 @@ const docTemplatestandard = `{
                     "$ref": "#/definitions/model.SrvContainer"
                 },
                 "image": {
-                    "$ref": "#/definitions/github_com_SENERGY-Platform_mgw-core-manager_lib_model.Image"
+                    "$ref": "#/definitions/model.Image"
                 },
                 "name": {
                     "type": "string"
@@ -792,9 +1728,15 @@ const docTemplatestandard = `{
         "model.Endpoint": {
             "type": "object",
             "properties": {
+                "auth": {
+                    "$ref": "#/definitions/model.AuthConfig"
+                },
                 "ext_path": {
                     "type": "string"
                 },
+                "health_check": {
+                    "$ref": "#/definitions/model.HealthCheck"
+                },
                 "host": {
                     "type": "string"
                 },
@@ -813,6 +1755,14 @@ const docTemplatestandard = `{
                 "location": {
                     "type": "string"
                 },
+                "maintenance": {
+                    "description": "set while the endpoint is in maintenance mode",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.EndpointMaintenance"
+                        }
+                    ]
+                },
                 "parent_id": {
                     "type": "string"
                 },
@@ -822,14 +1772,45 @@ const docTemplatestandard = `{
                 "proxy_conf": {
                     "$ref": "#/definitions/model.ProxyConfig"
                 },
+                "redirect": {
+                    "description": "respond with a redirect instead of proxying requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Redirect"
+                        }
+                    ]
+                },
                 "ref": {
                     "type": "string"
                 },
+                "static": {
+                    "description": "respond with a static response instead of proxying requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.StaticResponse"
+                        }
+                    ]
+                },
+                "status": {
+                    "type": "string"
+                },
                 "string_sub": {
                     "$ref": "#/definitions/model.StringSub"
                 },
+                "subdomain": {
+                    "description": "route requests by host header via a dedicated server block instead of a path prefix",
+                    "type": "boolean"
+                },
                 "type": {
                     "$ref": "#/definitions/model.EndpointType"
+                },
+                "upstream": {
+                    "description": "load balance requests across multiple targets instead of host and port",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Upstream"
+                        }
+                    ]
                 }
             }
         },
@@ -844,9 +1825,15 @@ const docTemplatestandard = `{
         "model.EndpointBase": {
             "type": "object",
             "properties": {
+                "auth": {
+                    "$ref": "#/definitions/model.AuthConfig"
+                },
                 "ext_path": {
                     "type": "string"
                 },
+                "health_check": {
+                    "$ref": "#/definitions/model.HealthCheck"
+                },
                 "host": {
                     "type": "string"
                 },
@@ -865,11 +1852,87 @@ const docTemplatestandard = `{
                 "proxy_conf": {
                     "$ref": "#/definitions/model.ProxyConfig"
                 },
+                "redirect": {
+                    "description": "respond with a redirect instead of proxying requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Redirect"
+                        }
+                    ]
+                },
                 "ref": {
                     "type": "string"
                 },
+                "static": {
+                    "description": "respond with a static response instead of proxying requests",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.StaticResponse"
+                        }
+                    ]
+                },
                 "string_sub": {
                     "$ref": "#/definitions/model.StringSub"
+                },
+                "subdomain": {
+                    "description": "route requests by host header via a dedicated server block instead of a path prefix",
+                    "type": "boolean"
+                },
+                "upstream": {
+                    "description": "load balance requests across multiple targets instead of host and port",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.Upstream"
+                        }
+                    ]
+                }
+            }
+        },
+        "model.EndpointConfigVersion": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "job_id": {
+                    "type": "string"
+                },
+                "removed": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "replaced": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "timestamp": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.EndpointMaintenance": {
+            "type": "object",
+            "properties": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "retry_after": {
+                    "description": "value of the Retry-After header, 0 -\u003e header omitted",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/time.Duration"
+                        }
+                    ]
                 }
             }
         },
@@ -878,14 +1941,54 @@ const docTemplatestandard = `{
             "enum": [
                 1,
                 2,
-                3
+                3,
+                4,
+                5,
+                6,
+                7
             ],
             "x-enum-varnames": [
                 "StandardEndpoint",
                 "AliasEndpoint",
-                "DefaultGuiEndpoint"
+                "DefaultGuiEndpoint",
+                "SubdomainEndpoint",
+                "SubdomainAliasEndpoint",
+                "RedirectEndpoint",
+                "StaticEndpoint"
             ]
         },
+        "model.HealthCheck": {
+            "type": "object",
+            "properties": {
+                "expected_status": {
+                    "description": "0 -\u003e any 2xx or 3xx status",
+                    "type": "integer"
+                },
+                "interval": {
+                    "description": "0 -\u003e default interval",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/time.Duration"
+                        }
+                    ]
+                },
+                "path": {
+                    "description": "request path on the target, empty -\u003e int_path",
+                    "type": "string"
+                }
+            }
+        },
+        "model.Image": {
+            "type": "object",
+            "properties": {
+                "repository": {
+                    "type": "string"
+                },
+                "tag": {
+                    "type": "string"
+                }
+            }
+        },
         "model.Log": {
             "type": "object",
             "properties": {
@@ -897,23 +2000,128 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "model.LogFile": {
+            "type": "object",
+            "properties": {
+                "compressed": {
+                    "type": "boolean"
+                },
+                "mod_time": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "size": {
+                    "type": "integer"
+                }
+            }
+        },
+        "model.LogReq": {
+            "type": "object",
+            "properties": {
+                "format": {
+                    "description": "text (default) or json",
+                    "type": "string"
+                },
+                "max_files": {
+                    "description": "rotated files to keep, 0 -\u003e keep all",
+                    "type": "integer"
+                },
+                "max_size": {
+                    "description": "bytes, 0 -\u003e no rotation",
+                    "type": "integer"
+                },
+                "move_create": {
+                    "description": "move the file instead of copy and truncate, only for writers that reopen the file on their own",
+                    "type": "boolean"
+                },
+                "path": {
+                    "type": "string"
+                },
+                "service_name": {
+                    "description": "letters, digits, '_', '.' and '-'",
+                    "type": "string"
+                },
+                "time_layout": {
+                    "type": "string"
+                }
+            }
+        },
         "model.ProxyConfig": {
             "type": "object",
             "properties": {
+                "buffering": {
+                    "description": "nil -\u003e nginx default, disable for server-sent events",
+                    "type": "boolean"
+                },
+                "client_max_body_size": {
+                    "description": "nginx size value (e.g. 10m), 0 -\u003e unlimited, empty -\u003e nginx default",
+                    "type": "string"
+                },
+                "conn_limit": {
+                    "description": "concurrent connections per client, 0 -\u003e unlimited",
+                    "type": "integer"
+                },
+                "connect_timeout": {
+                    "$ref": "#/definitions/time.Duration"
+                },
                 "headers": {
                     "type": "object",
                     "additionalProperties": {
                         "type": "string"
                     }
                 },
+                "hide_headers": {
+                    "description": "removed from upstream responses",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "read_timeout": {
                     "$ref": "#/definitions/time.Duration"
                 },
+                "req_burst": {
+                    "description": "requests exceeding the rate that are delayed before rejecting",
+                    "type": "integer"
+                },
+                "req_rate": {
+                    "description": "requests per second and client, 0 -\u003e unlimited",
+                    "type": "integer"
+                },
+                "request_buffering": {
+                    "description": "nil -\u003e nginx default",
+                    "type": "boolean"
+                },
+                "response_headers": {
+                    "description": "added to responses",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "send_timeout": {
+                    "$ref": "#/definitions/time.Duration"
+                },
                 "websocket": {
                     "type": "boolean"
                 }
             }
         },
+        "model.Redirect": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "301, 302 or 307, 0 -\u003e 302",
+                    "type": "integer"
+                },
+                "target": {
+                    "description": "absolute URL or path, nginx variables like $request_uri can be used",
+                    "type": "string"
+                }
+            }
+        },
         "model.SrvContainer": {
             "type": "object",
             "properties": {
@@ -928,6 +2136,108 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "model.SrvInfo": {
+            "type": "object",
+            "properties": {
+                "mem_stats": {
+                    "$ref": "#/definitions/lib.MemStats"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "up_time": {
+                    "$ref": "#/definitions/time.Duration"
+                },
+                "version": {
+                    "type": "string"
+                },
+                "warnings": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.StaticResponse": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "content_type": {
+                    "description": "empty -\u003e text/plain",
+                    "type": "string"
+                },
+                "file": {
+                    "description": "path relative to the configured static files directory, used instead of body",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "0 -\u003e 200",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StreamEndpoint": {
+            "type": "object",
+            "properties": {
+                "ext_port": {
+                    "description": "0 -\u003e allocate free port from configured range",
+                    "type": "integer"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "tcp or udp, empty -\u003e tcp",
+                    "type": "string"
+                },
+                "ref": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.StreamEndpointBase": {
+            "type": "object",
+            "properties": {
+                "ext_port": {
+                    "description": "0 -\u003e allocate free port from configured range",
+                    "type": "integer"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "labels": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "tcp or udp, empty -\u003e tcp",
+                    "type": "string"
+                },
+                "ref": {
+                    "type": "string"
+                }
+            }
+        },
         "model.StringSub": {
             "type": "object",
             "properties": {
@@ -951,6 +2261,40 @@ const docTemplatestandard = `{
                 }
             }
         },
+        "model.Upstream": {
+            "type": "object",
+            "properties": {
+                "method": {
+                    "description": "round_robin, least_conn or ip_hash, empty -\u003e round_robin",
+                    "type": "string"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.UpstreamTarget"
+                    }
+                }
+            }
+        },
+        "model.UpstreamTarget": {
+            "type": "object",
+            "properties": {
+                "backup": {
+                    "description": "only receives requests if all other targets are unavailable",
+                    "type": "boolean"
+                },
+                "host": {
+                    "type": "string"
+                },
+                "port": {
+                    "type": "integer"
+                },
+                "weight": {
+                    "description": "0 -\u003e nginx default",
+                    "type": "integer"
+                }
+            }
+        },
         "time.Duration": {
             "type": "integer",
             "enum": [
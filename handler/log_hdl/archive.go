@@ -0,0 +1,116 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+const gzipExt = ".gz"
+
+type logFile struct {
+	Path    string
+	Size    int64
+	ModTime time.Time
+}
+
+// getRotatedFiles returns rotated siblings of a log file (e.g. app.log.1, app.log.2.gz, app.log-20240101.gz) ordered from newest to oldest.
+func getRotatedFiles(p string) ([]logFile, error) {
+	dir, base := path.Split(p)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var files []logFile
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == base || !(strings.HasPrefix(name, base+".") || strings.HasPrefix(name, base+"-")) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !info.Mode().IsRegular() {
+			continue
+		}
+		files = append(files, logFile{
+			Path:    path.Join(dir, name),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		})
+	}
+	sort.SliceStable(files, func(i, j int) bool {
+		return files[i].ModTime.After(files[j].ModTime)
+	})
+	return files, nil
+}
+
+// archiveReader opens a rotated log file on first read, decompresses it if required and closes it once consumed.
+type archiveReader struct {
+	path   string
+	file   *os.File
+	rc     io.ReadCloser
+	closed bool
+}
+
+func (r *archiveReader) Read(p []byte) (int, error) {
+	if r.closed {
+		return 0, io.EOF
+	}
+	if r.file == nil {
+		file, err := os.Open(r.path)
+		if err != nil {
+			return 0, err
+		}
+		r.file = file
+		r.rc = file
+		if strings.HasSuffix(r.path, gzipExt) {
+			gr, err := gzip.NewReader(file)
+			if err != nil {
+				return 0, err
+			}
+			r.rc = gr
+		}
+	}
+	n, err := r.rc.Read(p)
+	if err == io.EOF {
+		_ = r.Close()
+	}
+	return n, err
+}
+
+func (r *archiveReader) Close() error {
+	if r.closed || r.file == nil {
+		r.closed = true
+		return nil
+	}
+	r.closed = true
+	if r.rc != io.ReadCloser(r.file) {
+		_ = r.rc.Close()
+	}
+	return r.file.Close()
+}
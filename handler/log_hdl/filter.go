@@ -0,0 +1,238 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const timePrefixLen = 64
+
+var defaultTimeLayouts = []string{
+	time.RFC3339,
+	time.DateTime,
+	"2006/01/02 15:04:05",
+	"Jan 2 15:04:05",
+}
+
+type lineFilter struct {
+	since       time.Time
+	until       time.Time
+	substr      []byte
+	re          *regexp.Regexp
+	layouts     []string
+	jsonFmt     bool
+	level       string
+	fieldFilter map[string]string
+	lastTime    *time.Time
+}
+
+func newLineFilter(filter lib_model.LogFilter, format, timeLayout string) (*lineFilter, error) {
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Until.Before(filter.Since) {
+		return nil, errors.New("until before since")
+	}
+	if format != FormatJSON && (filter.Level != "" || len(filter.FieldFilter) > 0) {
+		return nil, errors.New("level and field filters require json format")
+	}
+	lf := lineFilter{
+		since:       filter.Since,
+		until:       filter.Until,
+		layouts:     defaultTimeLayouts,
+		jsonFmt:     format == FormatJSON,
+		level:       filter.Level,
+		fieldFilter: filter.FieldFilter,
+	}
+	if timeLayout != "" {
+		lf.layouts = []string{timeLayout}
+	}
+	if filter.Grep != "" {
+		if filter.GrepRegex {
+			re, err := regexp.Compile(filter.Grep)
+			if err != nil {
+				return nil, err
+			}
+			lf.re = re
+		} else {
+			lf.substr = []byte(filter.Grep)
+		}
+	}
+	return &lf, nil
+}
+
+// match reports whether a line passes the filter and if no further lines can pass because the until timestamp has been exceeded.
+// Lines without a timestamp inherit the timestamp of the previous line.
+func (f *lineFilter) match(line []byte) (bool, bool) {
+	var fields map[string]any
+	if f.jsonFmt {
+		fields, _ = parseJSONLine(line)
+	}
+	if !f.since.IsZero() || !f.until.IsZero() {
+		var t time.Time
+		var ok bool
+		if fields != nil {
+			t, ok = getEntryTime(fields, f.layouts)
+		} else {
+			t, ok = parseTime(line, f.layouts)
+		}
+		if ok {
+			f.lastTime = &t
+		}
+		if f.lastTime == nil {
+			return false, false
+		}
+		if !f.until.IsZero() && f.lastTime.After(f.until) {
+			return false, true
+		}
+		if !f.since.IsZero() && f.lastTime.Before(f.since) {
+			return false, false
+		}
+	}
+	if f.level != "" || len(f.fieldFilter) > 0 {
+		if fields == nil {
+			return false, false
+		}
+		if f.level != "" && !strings.EqualFold(getEntryLevel(fields), f.level) {
+			return false, false
+		}
+		for key, val := range f.fieldFilter {
+			v, ok := fields[key]
+			if !ok || fmt.Sprint(v) != val {
+				return false, false
+			}
+		}
+	}
+	if f.re != nil {
+		return f.re.Match(line), false
+	}
+	if f.substr != nil {
+		return bytes.Contains(line, f.substr), false
+	}
+	return true, false
+}
+
+func parseTime(line []byte, layouts []string) (time.Time, bool) {
+	if len(line) > timePrefixLen {
+		line = line[:timePrefixLen]
+	}
+	fields := strings.Fields(strings.TrimLeft(string(line), "["))
+	for _, layout := range layouts {
+		n := len(strings.Fields(layout))
+		if n == 0 || len(fields) < n {
+			continue
+		}
+		t, err := time.Parse(layout, strings.TrimRight(strings.Join(fields[:n], " "), "]:,"))
+		if err != nil {
+			continue
+		}
+		if t.Year() == 0 {
+			t = t.AddDate(time.Now().Year(), 0, 0)
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// newFilterReader streams matching lines of the given rotated files (oldest first) and the current log file.
+func newFilterReader(ctx context.Context, file *os.File, path string, rotated []string, lf *lineFilter, ew *entryWriter, maxLines int, follow bool, pollInterval time.Duration) (io.ReadCloser, error) {
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		var rc io.ReadCloser = file
+		var readers []io.Reader
+		for _, p := range rotated {
+			ar := &archiveReader{path: p}
+			defer ar.Close()
+			readers = append(readers, ar)
+		}
+		readers = append(readers, io.LimitReader(file, info.Size()))
+		defer func() {
+			rc.Close()
+		}()
+		var w io.Writer = pw
+		if ew != nil {
+			ew.w = pw
+			w = ew
+		}
+		err := filterLines(ctx, io.MultiReader(readers...), w, lf, maxLines)
+		if err == nil && follow {
+			rc = newFollowReader(ctx, file, path, pollInterval)
+			err = filterLines(ctx, rc, w, lf, 0)
+		}
+		if errors.Is(err, errUntilExceeded) {
+			err = nil
+		}
+		if err == nil && ew != nil {
+			err = ew.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr, nil
+}
+
+var errUntilExceeded = errors.New("until exceeded")
+
+// filterLines writes matching lines from r to w. If maxLines is greater than zero, only the last matching lines are written
+// once r has been consumed.
+func filterLines(ctx context.Context, r io.Reader, w io.Writer, lf *lineFilter, maxLines int) error {
+	var buffer [][]byte
+	reader := bufio.NewReader(r)
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		line, rErr := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			ok, done := lf.match(line)
+			if done {
+				rErr = errUntilExceeded
+			} else if ok {
+				if maxLines > 0 {
+					if len(buffer) == maxLines {
+						buffer = buffer[1:]
+					}
+					buffer = append(buffer, line)
+				} else if _, err := w.Write(line); err != nil {
+					return err
+				}
+			}
+		}
+		if rErr != nil {
+			for _, l := range buffer {
+				if _, err := w.Write(l); err != nil {
+					return err
+				}
+			}
+			if rErr == io.EOF {
+				return nil
+			}
+			return rErr
+		}
+	}
+}
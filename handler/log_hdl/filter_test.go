@@ -0,0 +1,144 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFilterLines(t *testing.T) {
+	textLines := "2025-01-01T10:00:00Z a info\n" +
+		"continuation line\n" +
+		"2025-01-01T11:00:00Z b error\n" +
+		"2025-01-01T12:00:00Z c info\n" +
+		"2025-01-01T13:00:00Z d debug\n"
+	jsonLines := `{"time":"2025-01-01T10:00:00Z","level":"info","msg":"a","svc":"x"}` + "\n" +
+		`{"time":"2025-01-01T11:00:00Z","level":"error","msg":"b","svc":"y"}` + "\n" +
+		`{"time":1735732800,"level":"info","msg":"c","svc":"y"}` + "\n" +
+		"not json\n"
+	ts := func(s string) time.Time {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			panic(err)
+		}
+		return t
+	}
+	tests := []struct {
+		name     string
+		input    string
+		format   string
+		filter   lib_model.LogFilter
+		maxLines int
+		want     []string
+	}{
+		{name: "no filter", input: textLines, want: []string{"a", "continuation", "b", "c", "d"}},
+		{name: "since", input: textLines, filter: lib_model.LogFilter{Since: ts("2025-01-01T11:00:00Z")}, want: []string{"b", "c", "d"}},
+		{name: "until", input: textLines, filter: lib_model.LogFilter{Until: ts("2025-01-01T11:30:00Z")}, want: []string{"a", "continuation", "b"}},
+		{name: "since and until", input: textLines, filter: lib_model.LogFilter{Since: ts("2025-01-01T10:30:00Z"), Until: ts("2025-01-01T12:00:00Z")}, want: []string{"b", "c"}},
+		{name: "continuation inherits time", input: textLines, filter: lib_model.LogFilter{Until: ts("2025-01-01T10:30:00Z")}, want: []string{"a", "continuation"}},
+		{name: "grep", input: textLines, filter: lib_model.LogFilter{Grep: "info"}, want: []string{"a", "c"}},
+		{name: "grep regex", input: textLines, filter: lib_model.LogFilter{Grep: `\s(b|d)\s`, GrepRegex: true}, want: []string{"b", "d"}},
+		{name: "max lines", input: textLines, maxLines: 2, want: []string{"c", "d"}},
+		{name: "max lines and grep", input: textLines, filter: lib_model.LogFilter{Grep: "info"}, maxLines: 1, want: []string{"c"}},
+		{name: "json level", input: jsonLines, format: FormatJSON, filter: lib_model.LogFilter{Level: "ERROR"}, want: []string{`"b"`}},
+		{name: "json field", input: jsonLines, format: FormatJSON, filter: lib_model.LogFilter{FieldFilter: map[string]string{"svc": "y"}}, want: []string{`"b"`, `"c"`}},
+		{name: "json since", input: jsonLines, format: FormatJSON, filter: lib_model.LogFilter{Since: ts("2025-01-01T11:00:00Z")}, want: []string{`"b"`, `"c"`, "not json"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			lf, err := newLineFilter(tc.filter, tc.format, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buf bytes.Buffer
+			err = filterLines(context.Background(), strings.NewReader(tc.input), &buf, lf, tc.maxLines)
+			if err != nil && !errors.Is(err, errUntilExceeded) {
+				t.Fatal(err)
+			}
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			if buf.Len() == 0 {
+				lines = nil
+			}
+			if len(lines) != len(tc.want) {
+				t.Fatalf("expected %d lines, got %q", len(tc.want), lines)
+			}
+			for i, line := range lines {
+				if !strings.Contains(line, tc.want[i]) {
+					t.Errorf("line %d: expected '%s', got '%s'", i, tc.want[i], line)
+				}
+			}
+		})
+	}
+}
+
+func TestNewLineFilter(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		filter lib_model.LogFilter
+		format string
+	}{
+		{name: "until before since", filter: lib_model.LogFilter{Since: now, Until: now.Add(-time.Second)}},
+		{name: "level without json", filter: lib_model.LogFilter{Level: "info"}, format: FormatText},
+		{name: "field filter without json", filter: lib_model.LogFilter{FieldFilter: map[string]string{"a": "b"}}},
+		{name: "invalid regex", filter: lib_model.LogFilter{Grep: "(", GrepRegex: true}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := newLineFilter(tc.filter, tc.format, ""); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		line    string
+		layouts []string
+		want    time.Time
+		ok      bool
+	}{
+		{line: "2025-01-02T03:04:05Z message", layouts: defaultTimeLayouts, want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "[2025-01-02T03:04:05Z] message", layouts: defaultTimeLayouts, want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "2025-01-02T03:04:05Z, message", layouts: defaultTimeLayouts, want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "2025-01-02 03:04:05 message", layouts: defaultTimeLayouts, want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "2025/01/02 03:04:05 message", layouts: defaultTimeLayouts, want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "Jan  2 03:04:05 host message", layouts: defaultTimeLayouts, want: time.Date(time.Now().Year(), 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "02.01.2025 03:04:05: message", layouts: []string{"02.01.2006 15:04:05"}, want: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), ok: true},
+		{line: "2025-01-02T03:04:05Z message", layouts: []string{"02.01.2006 15:04:05"}},
+		{line: "message without time", layouts: defaultTimeLayouts},
+		{line: "", layouts: defaultTimeLayouts},
+	}
+	for _, tc := range tests {
+		t.Run(tc.line, func(t *testing.T) {
+			got, ok := parseTime([]byte(tc.line), tc.layouts)
+			if ok != tc.ok {
+				t.Fatalf("expected ok %v, got %v", tc.ok, ok)
+			}
+			if !got.Equal(tc.want) {
+				t.Errorf("expected %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
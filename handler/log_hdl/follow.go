@@ -0,0 +1,108 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+)
+
+type followReader struct {
+	ctx      context.Context
+	file     *os.File
+	path     string
+	interval time.Duration
+}
+
+func newFollowReader(ctx context.Context, file *os.File, path string, interval time.Duration) *followReader {
+	return &followReader{
+		ctx:      ctx,
+		file:     file,
+		path:     path,
+		interval: interval,
+	}
+}
+
+func (r *followReader) Read(p []byte) (int, error) {
+	for {
+		n, err := r.file.Read(p)
+		if n > 0 {
+			return n, nil
+		}
+		if err != nil && err != io.EOF {
+			return 0, err
+		}
+		reopened, err := r.reopen()
+		if err != nil {
+			return 0, err
+		}
+		if reopened {
+			continue
+		}
+		timer := time.NewTimer(r.interval)
+		select {
+		case <-timer.C:
+		case <-r.ctx.Done():
+			timer.Stop()
+			return 0, io.EOF
+		}
+	}
+}
+
+func (r *followReader) Close() error {
+	return r.file.Close()
+}
+
+// reopen switches to a new file if the log has been rotated or rewinds the current file if it has been truncated.
+func (r *followReader) reopen() (bool, error) {
+	pathInfo, err := os.Stat(r.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	fileInfo, err := r.file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if !os.SameFile(pathInfo, fileInfo) {
+		file, err := os.Open(r.path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		_ = r.file.Close()
+		r.file = file
+		return true, nil
+	}
+	pos, err := r.file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, err
+	}
+	if fileInfo.Size() < pos {
+		if _, err = r.file.Seek(0, io.SeekStart); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestFollowReader(t *testing.T) {
+	p := path.Join(t.TempDir(), "test.log")
+	if err := os.WriteFile(p, []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = file.Seek(0, io.SeekEnd); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cf := context.WithCancel(context.Background())
+	defer cf()
+	fr := newFollowReader(ctx, file, p, time.Millisecond*10)
+	defer fr.Close()
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(fr)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+	expect := func(want string) {
+		t.Helper()
+		select {
+		case line := <-lines:
+			if line != want {
+				t.Errorf("expected '%s', got '%s'", want, line)
+			}
+		case <-time.After(time.Second * 2):
+			t.Fatalf("timeout waiting for '%s'", want)
+		}
+	}
+	appendLine := func(p, line string) {
+		t.Helper()
+		f, err := os.OpenFile(p, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer f.Close()
+		if _, err = f.WriteString(line + "\n"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Run("append", func(t *testing.T) {
+		appendLine(p, "b")
+		expect("b")
+	})
+	t.Run("truncate", func(t *testing.T) {
+		if err := os.Truncate(p, 0); err != nil {
+			t.Fatal(err)
+		}
+		appendLine(p, "c")
+		expect("c")
+	})
+	t.Run("rotate", func(t *testing.T) {
+		if err := os.Rename(p, p+".1"); err != nil {
+			t.Fatal(err)
+		}
+		appendLine(p, "d")
+		expect("d")
+		appendLine(p, "e")
+		expect("e")
+	})
+	cf()
+	select {
+	case _, ok := <-lines:
+		if ok {
+			t.Error("unexpected line")
+		}
+	case <-time.After(time.Second * 2):
+		t.Error("reader not stopped")
+	}
+}
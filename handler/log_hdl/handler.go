@@ -26,33 +26,101 @@ import (
 	"io"
 	"os"
 	"path"
+	"strings"
+	"sync"
+	"time"
 )
 
 type Log struct {
-	Name string `json:"name"`
-	Path string `json:"path"`
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+	TimeLayout string `json:"time_layout"`
+	Format     string `json:"format"`      // text (default) or json
+	MaxSize    int64  `json:"max_size"`    // bytes, 0 -> no rotation
+	MaxFiles   int    `json:"max_files"`   // rotated files to keep, 0 -> keep all
+	MoveCreate bool   `json:"move_create"` // move the file instead of copy and truncate, only for writers that reopen the file on their own
+}
+
+type ctrLog struct {
+	Name   string
+	CtrHdl ContainerHandler
 }
 
 type Handler struct {
-	logs       map[string]Log
-	bufferSize int64
+	confPath     string
+	regPath      string
+	allowedDirs  []string
+	logs         map[string]Log
+	regLogs      map[string]Log
+	ctrLogs      map[string]ctrLog
+	bufferSize   int64
+	pollInterval time.Duration
+	mu           sync.RWMutex
+	rotMu        sync.Mutex
+	running      bool
+	loopMu       sync.RWMutex
+	dChan        chan struct{}
 }
 
-func New(logs []Log, bufferSize int) (*Handler, error) {
-	lMap := make(map[string]Log)
-	for _, log := range logs {
-		if !path.IsAbs(log.Path) {
-			return nil, fmt.Errorf("path not absolute: %s", log.Path)
+func New(confPath string, allowedDirs []string, ctrHandlers map[string]ContainerHandler, bufferSize int, pollInterval time.Duration) *Handler {
+	cMap := make(map[string]ctrLog)
+	for name, ctrHdl := range ctrHandlers {
+		cMap[util.GenHash(name)] = ctrLog{
+			Name:   name,
+			CtrHdl: ctrHdl,
 		}
-		lMap[util.GenHash(log.Path)] = log
 	}
 	return &Handler{
-		logs:       lMap,
-		bufferSize: int64(bufferSize),
-	}, nil
+		confPath:     confPath,
+		regPath:      path.Join(path.Dir(confPath), regFileName),
+		allowedDirs:  allowedDirs,
+		ctrLogs:      cMap,
+		bufferSize:   int64(bufferSize),
+		pollInterval: pollInterval,
+		dChan:        make(chan struct{}),
+	}
+}
+
+func (h *Handler) Init() error {
+	logs, err := ReadConfig(h.regPath)
+	if err != nil {
+		return err
+	}
+	h.regLogs, err = genLogMap(logs)
+	if err != nil {
+		return err
+	}
+	return h.load(true)
+}
+
+// Reload reads the log configuration and replaces the current set of logs. The current set is kept if the configuration is invalid or missing.
+func (h *Handler) Reload() error {
+	return h.load(false)
+}
+
+func (h *Handler) load(allowMissing bool) error {
+	if !allowMissing {
+		if _, err := os.Stat(h.confPath); err != nil {
+			return err
+		}
+	}
+	logs, err := ReadConfig(h.confPath)
+	if err != nil {
+		return err
+	}
+	lMap, err := genLogMap(logs)
+	if err != nil {
+		return err
+	}
+	h.mu.Lock()
+	h.logs = lMap
+	h.mu.Unlock()
+	return nil
 }
 
 func (h *Handler) List(_ context.Context) ([]lib_model.Log, error) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
 	var logs []lib_model.Log
 	for id, log := range h.logs {
 		logs = append(logs, lib_model.Log{
@@ -60,11 +128,29 @@ func (h *Handler) List(_ context.Context) ([]lib_model.Log, error) {
 			ServiceName: log.Name,
 		})
 	}
+	for id, log := range h.regLogs {
+		if _, ok := h.logs[id]; ok {
+			continue
+		}
+		logs = append(logs, lib_model.Log{
+			ID:          id,
+			ServiceName: log.Name,
+		})
+	}
+	for id, log := range h.ctrLogs {
+		logs = append(logs, lib_model.Log{
+			ID:          id,
+			ServiceName: log.Name,
+		})
+	}
 	return logs, nil
 }
 
-func (h *Handler) GetReader(ctx context.Context, id string, numOfLines int) (io.ReadCloser, error) {
-	log, ok := h.logs[id]
+func (h *Handler) GetReader(ctx context.Context, id string, filter lib_model.LogFilter) (io.ReadCloser, error) {
+	if cLog, ok := h.ctrLogs[id]; ok {
+		return getCtrReader(ctx, cLog, filter)
+	}
+	log, ok := h.getLog(id)
 	if !ok {
 		return nil, lib_model.NewNotFoundError(errors.New("not found"))
 	}
@@ -77,13 +163,134 @@ func (h *Handler) GetReader(ctx context.Context, id string, numOfLines int) (io.
 			file.Close()
 		}
 	}()
-	_, err = seek(ctx, file, numOfLines, h.bufferSize)
+	if filter.Normalize && filter.Follow {
+		err = errors.New("normalize not supported with follow")
+		return nil, lib_model.NewInvalidInputError(err)
+	}
+	if !filter.Since.IsZero() || !filter.Until.IsZero() || filter.Grep != "" || filter.Generations > 0 || filter.Level != "" || len(filter.FieldFilter) > 0 || filter.Normalize {
+		var lf *lineFilter
+		lf, err = newLineFilter(filter, log.Format, log.TimeLayout)
+		if err != nil {
+			return nil, lib_model.NewInvalidInputError(err)
+		}
+		var rotated []string
+		if filter.Generations > 0 {
+			var files []logFile
+			files, err = getRotatedFiles(log.Path)
+			if err != nil {
+				return nil, lib_model.NewInternalError(err)
+			}
+			if len(files) > filter.Generations {
+				files = files[:filter.Generations]
+			}
+			for i := len(files) - 1; i >= 0; i-- {
+				rotated = append(rotated, files[i].Path)
+			}
+		}
+		var rc io.ReadCloser
+		var ew *entryWriter
+		if filter.Normalize {
+			ew = newEntryWriter(log.Format, log.TimeLayout, filter.Fields)
+		}
+		rc, err = newFilterReader(ctx, file, log.Path, rotated, lf, ew, filter.MaxLines, filter.Follow, h.pollInterval)
+		if err != nil {
+			return nil, lib_model.NewInternalError(err)
+		}
+		return rc, nil
+	}
+	_, err = seek(ctx, file, filter.MaxLines, h.bufferSize)
 	if err != nil {
 		return nil, lib_model.NewInternalError(err)
 	}
+	if filter.Follow {
+		return newFollowReader(ctx, file, log.Path, h.pollInterval), nil
+	}
 	return file, nil
 }
 
+func (h *Handler) ListFiles(_ context.Context, id string) ([]lib_model.LogFile, error) {
+	if _, ok := h.ctrLogs[id]; ok {
+		return nil, lib_model.NewInvalidInputError(errors.New("container logs have no files"))
+	}
+	log, ok := h.getLog(id)
+	if !ok {
+		return nil, lib_model.NewNotFoundError(errors.New("not found"))
+	}
+	info, err := os.Stat(log.Path)
+	if err != nil {
+		return nil, lib_model.NewInternalError(err)
+	}
+	files := []lib_model.LogFile{
+		{
+			Name:    path.Base(log.Path),
+			Size:    info.Size(),
+			ModTime: info.ModTime(),
+		},
+	}
+	rotated, err := getRotatedFiles(log.Path)
+	if err != nil {
+		return nil, lib_model.NewInternalError(err)
+	}
+	for _, file := range rotated {
+		files = append(files, lib_model.LogFile{
+			Name:       path.Base(file.Path),
+			Size:       file.Size,
+			ModTime:    file.ModTime,
+			Compressed: strings.HasSuffix(file.Path, gzipExt),
+		})
+	}
+	return files, nil
+}
+
+func (h *Handler) getLog(id string) (Log, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	log, ok := h.logs[id]
+	if !ok {
+		log, ok = h.regLogs[id]
+	}
+	return log, ok
+}
+
+func getCtrReader(ctx context.Context, cLog ctrLog, filter lib_model.LogFilter) (io.ReadCloser, error) {
+	// the container engine wrapper only provides completed log requests
+	if filter.Follow {
+		return nil, lib_model.NewInvalidInputError(errors.New("follow not supported for container logs"))
+	}
+	if filter.Grep == "" && filter.Level == "" && len(filter.FieldFilter) == 0 && !filter.Normalize {
+		return cLog.CtrHdl.GetLog(ctx, filter.MaxLines, filter.Since, filter.Until)
+	}
+	lf, err := newLineFilter(lib_model.LogFilter{
+		Grep:        filter.Grep,
+		GrepRegex:   filter.GrepRegex,
+		Level:       filter.Level,
+		FieldFilter: filter.FieldFilter,
+	}, FormatText, "")
+	if err != nil {
+		return nil, lib_model.NewInvalidInputError(err)
+	}
+	rc, err := cLog.CtrHdl.GetLog(ctx, 0, filter.Since, filter.Until)
+	if err != nil {
+		return nil, err
+	}
+	pr, pw := io.Pipe()
+	go func() {
+		defer rc.Close()
+		if !filter.Normalize {
+			pw.CloseWithError(filterLines(ctx, rc, pw, lf, filter.MaxLines))
+			return
+		}
+		ew := newEntryWriter(FormatText, "", filter.Fields)
+		ew.w = pw
+		err := filterLines(ctx, rc, ew, lf, filter.MaxLines)
+		if err == nil {
+			err = ew.Close()
+		}
+		pw.CloseWithError(err)
+	}()
+	return pr, nil
+}
+
 func ReadConfig(p string) ([]Log, error) {
 	file, err := os.Open(p)
 	if err != nil {
@@ -92,6 +299,7 @@ func ReadConfig(p string) ([]Log, error) {
 		}
 		return nil, err
 	}
+	defer file.Close()
 	var config []Log
 	decoder := json.NewDecoder(file)
 	err = decoder.Decode(&config)
@@ -100,3 +308,26 @@ func ReadConfig(p string) ([]Log, error) {
 	}
 	return config, nil
 }
+
+func genLogMap(logs []Log) (map[string]Log, error) {
+	lMap := make(map[string]Log)
+	for _, log := range logs {
+		if !path.IsAbs(log.Path) {
+			return nil, fmt.Errorf("path not absolute: %s", log.Path)
+		}
+		if err := validateFormat(log.Format); err != nil {
+			return nil, err
+		}
+		lMap[util.GenHash(log.Path)] = log
+	}
+	return lMap, nil
+}
+
+func validateFormat(format string) error {
+	switch format {
+	case "", FormatText, FormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
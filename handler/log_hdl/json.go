@@ -0,0 +1,197 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+var (
+	timeKeys    = []string{"time", "ts", "timestamp", "@timestamp"}
+	levelKeys   = []string{"level", "lvl", "severity"}
+	messageKeys = []string{"msg", "message"}
+)
+
+func parseJSONLine(line []byte) (map[string]any, bool) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 || line[0] != '{' {
+		return nil, false
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return nil, false
+	}
+	return fields, true
+}
+
+func getEntryTime(fields map[string]any, layouts []string) (time.Time, bool) {
+	for _, key := range timeKeys {
+		switch v := fields[key].(type) {
+		case string:
+			for _, layout := range append([]string{time.RFC3339Nano}, layouts...) {
+				if t, err := time.Parse(layout, v); err == nil {
+					return t, true
+				}
+			}
+		case float64:
+			if v > 1e12 {
+				return time.UnixMilli(int64(v)), true
+			}
+			sec, frac := int64(v), v-float64(int64(v))
+			return time.Unix(sec, int64(frac*1e9)), true
+		}
+	}
+	return time.Time{}, false
+}
+
+func getEntryLevel(fields map[string]any) string {
+	return getStringField(fields, levelKeys)
+}
+
+func getStringField(fields map[string]any, keys []string) string {
+	for _, key := range keys {
+		if v, ok := fields[key]; ok {
+			if s, ok := v.(string); ok {
+				return s
+			}
+			if f, ok := v.(float64); ok {
+				return strconv.FormatFloat(f, 'f', -1, 64)
+			}
+		}
+	}
+	return ""
+}
+
+func isReservedKey(key string) bool {
+	for _, keys := range [][]string{timeKeys, levelKeys, messageKeys} {
+		for _, k := range keys {
+			if k == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// entryWriter converts written lines to normalized log entries and writes them as a JSON array.
+type entryWriter struct {
+	w        io.Writer
+	jsonFmt  bool
+	layouts  []string
+	fields   map[string]struct{}
+	lastTime *time.Time
+	count    int
+	closed   bool
+}
+
+func newEntryWriter(format, timeLayout string, fields []string) *entryWriter {
+	ew := entryWriter{
+		jsonFmt: format == FormatJSON,
+		layouts: defaultTimeLayouts,
+	}
+	if timeLayout != "" {
+		ew.layouts = []string{timeLayout}
+	}
+	if len(fields) > 0 {
+		ew.fields = make(map[string]struct{})
+		for _, f := range fields {
+			ew.fields[f] = struct{}{}
+		}
+	}
+	return &ew
+}
+
+func (w *entryWriter) Write(line []byte) (int, error) {
+	if w.closed {
+		return 0, errors.New("writer closed")
+	}
+	b, err := json.Marshal(w.newEntry(line))
+	if err != nil {
+		return 0, err
+	}
+	if w.count == 0 {
+		b = append([]byte("["), b...)
+	} else {
+		b = append([]byte(","), b...)
+	}
+	if _, err = w.w.Write(b); err != nil {
+		return 0, err
+	}
+	w.count++
+	return len(line), nil
+}
+
+// Close terminates the JSON array.
+func (w *entryWriter) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+	if w.count == 0 {
+		_, err := w.w.Write([]byte("[]"))
+		return err
+	}
+	_, err := w.w.Write([]byte("]"))
+	return err
+}
+
+func (w *entryWriter) newEntry(line []byte) lib_model.LogEntry {
+	if w.jsonFmt {
+		if fields, ok := parseJSONLine(line); ok {
+			entry := lib_model.LogEntry{
+				Level:   getEntryLevel(fields),
+				Message: getStringField(fields, messageKeys),
+			}
+			if t, ok := getEntryTime(fields, w.layouts); ok {
+				entry.Time = &t
+			}
+			for key, val := range fields {
+				if isReservedKey(key) {
+					continue
+				}
+				if w.fields != nil {
+					if _, ok := w.fields[key]; !ok {
+						continue
+					}
+				}
+				if entry.Fields == nil {
+					entry.Fields = make(map[string]any)
+				}
+				entry.Fields[key] = val
+			}
+			return entry
+		}
+	}
+	entry := lib_model.LogEntry{Message: strings.TrimRight(string(line), "\r\n")}
+	if t, ok := parseTime(line, w.layouts); ok {
+		w.lastTime = &t
+	}
+	entry.Time = w.lastTime
+	return entry
+}
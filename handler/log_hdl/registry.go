@@ -0,0 +1,142 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const regFileName = "registered_logs.json"
+
+var serviceNameRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+func (h *Handler) Add(_ context.Context, logReq lib_model.LogReq) (string, error) {
+	log := Log{
+		Name:       logReq.ServiceName,
+		Path:       logReq.Path,
+		TimeLayout: logReq.TimeLayout,
+		Format:     logReq.Format,
+		MaxSize:    logReq.MaxSize,
+		MaxFiles:   logReq.MaxFiles,
+		MoveCreate: logReq.MoveCreate,
+	}
+	if log.Name == "" {
+		return "", lib_model.NewInvalidInputError(errors.New("missing service name"))
+	}
+	if !serviceNameRegex.MatchString(log.Name) {
+		return "", lib_model.NewInvalidInputError(fmt.Errorf("invalid service name '%s'", log.Name))
+	}
+	if !path.IsAbs(log.Path) {
+		return "", lib_model.NewInvalidInputError(fmt.Errorf("path '%s' not absolute", log.Path))
+	}
+	if err := validateFormat(log.Format); err != nil {
+		return "", lib_model.NewInvalidInputError(err)
+	}
+	if log.MaxSize < 0 || log.MaxFiles < 0 {
+		return "", lib_model.NewInvalidInputError(errors.New("invalid rotation settings"))
+	}
+	log.Path = path.Clean(log.Path)
+	if !h.pathAllowed(log.Path) {
+		return "", lib_model.NewNotAllowedError(fmt.Errorf("path '%s' not allowed", log.Path))
+	}
+	id := util.GenHash(log.Path)
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.logs[id]; ok {
+		return "", lib_model.NewInvalidInputError(fmt.Errorf("log '%s' already defined", log.Path))
+	}
+	if _, ok := h.regLogs[id]; ok {
+		return "", lib_model.NewInvalidInputError(fmt.Errorf("log '%s' already registered", log.Path))
+	}
+	regLogs := make(map[string]Log)
+	for id2, l := range h.regLogs {
+		regLogs[id2] = l
+	}
+	regLogs[id] = log
+	if err := writeConfig(h.regPath, regLogs); err != nil {
+		return "", lib_model.NewInternalError(err)
+	}
+	h.regLogs = regLogs
+	return id, nil
+}
+
+func (h *Handler) Remove(_ context.Context, id string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.regLogs[id]; !ok {
+		if _, ok = h.logs[id]; ok {
+			return lib_model.NewNotAllowedError(fmt.Errorf("remove log '%s' not allowed", id))
+		}
+		return lib_model.NewNotFoundError(fmt.Errorf("log '%s' not found", id))
+	}
+	regLogs := make(map[string]Log)
+	for id2, l := range h.regLogs {
+		if id2 != id {
+			regLogs[id2] = l
+		}
+	}
+	if err := writeConfig(h.regPath, regLogs); err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	h.regLogs = regLogs
+	return nil
+}
+
+func (h *Handler) pathAllowed(p string) bool {
+	for _, dir := range h.allowedDirs {
+		dir = path.Clean(dir)
+		if path.IsAbs(dir) && strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func writeConfig(p string, logs map[string]Log) error {
+	config := make([]Log, 0, len(logs))
+	for _, log := range logs {
+		config = append(config, log)
+	}
+	sort.Slice(config, func(i, j int) bool {
+		return config[i].Path < config[j].Path
+	})
+	file, err := os.Create(p + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(p + ".tmp")
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(config); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(p+".tmp", p)
+}
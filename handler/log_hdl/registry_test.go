@@ -0,0 +1,122 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"context"
+	"errors"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"path"
+	"testing"
+	"time"
+)
+
+func newTestRegHandler(t *testing.T, confPath string) *Handler {
+	t.Helper()
+	h := New(confPath, []string{"/var/log/app", "/data/"}, nil, 1024, time.Second)
+	if err := h.Init(); err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+func TestAdd(t *testing.T) {
+	confPath := path.Join(t.TempDir(), "logs.json")
+	writeFile(t, confPath, `[{"name":"defined","path":"/var/log/app/defined.log"}]`)
+	h := newTestRegHandler(t, confPath)
+	tests := []struct {
+		name string
+		req  lib_model.LogReq
+		err  any
+	}{
+		{name: "valid", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/svc.log"}},
+		{name: "valid trailing slash dir", req: lib_model.LogReq{ServiceName: "svc-2.a_b", Path: "/data/sub/svc.log", Format: FormatJSON}},
+		{name: "already registered", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/./svc.log"}, err: new(*lib_model.InvalidInputError)},
+		{name: "already defined", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/defined.log"}, err: new(*lib_model.InvalidInputError)},
+		{name: "outside allowed dirs", req: lib_model.LogReq{ServiceName: "svc", Path: "/etc/passwd"}, err: new(*lib_model.NotAllowedError)},
+		{name: "common prefix", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/apple/svc.log"}, err: new(*lib_model.NotAllowedError)},
+		{name: "allowed dir", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app"}, err: new(*lib_model.NotAllowedError)},
+		{name: "path traversal", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/../secret.log"}, err: new(*lib_model.NotAllowedError)},
+		{name: "relative path", req: lib_model.LogReq{ServiceName: "svc", Path: "var/log/app/svc.log"}, err: new(*lib_model.InvalidInputError)},
+		{name: "missing service name", req: lib_model.LogReq{Path: "/var/log/app/a.log"}, err: new(*lib_model.InvalidInputError)},
+		{name: "invalid service name", req: lib_model.LogReq{ServiceName: "-svc", Path: "/var/log/app/a.log"}, err: new(*lib_model.InvalidInputError)},
+		{name: "service name with space", req: lib_model.LogReq{ServiceName: "my svc", Path: "/var/log/app/a.log"}, err: new(*lib_model.InvalidInputError)},
+		{name: "unknown format", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/a.log", Format: "xml"}, err: new(*lib_model.InvalidInputError)},
+		{name: "negative max size", req: lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/a.log", MaxSize: -1}, err: new(*lib_model.InvalidInputError)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := h.Add(context.Background(), tc.req)
+			if tc.err == nil {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if id != util.GenHash(path.Clean(tc.req.Path)) {
+					t.Errorf("unexpected id '%s'", id)
+				}
+				return
+			}
+			if !errors.As(err, tc.err) {
+				t.Errorf("expected %T, got '%v'", tc.err, err)
+			}
+		})
+	}
+	// registered logs are persisted
+	h2 := newTestRegHandler(t, confPath)
+	if len(h2.regLogs) != 2 {
+		t.Fatalf("expected 2 registered logs, got %d", len(h2.regLogs))
+	}
+	log, ok := h2.getLog(util.GenHash("/data/sub/svc.log"))
+	if !ok || log.Name != "svc-2.a_b" || log.Format != FormatJSON {
+		t.Errorf("unexpected log %+v", log)
+	}
+}
+
+func TestRemove(t *testing.T) {
+	confPath := path.Join(t.TempDir(), "logs.json")
+	writeFile(t, confPath, `[{"name":"defined","path":"/var/log/app/defined.log"}]`)
+	h := newTestRegHandler(t, confPath)
+	id, err := h.Add(context.Background(), lib_model.LogReq{ServiceName: "svc", Path: "/var/log/app/svc.log"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nae *lib_model.NotAllowedError
+	if err = h.Remove(context.Background(), util.GenHash("/var/log/app/defined.log")); !errors.As(err, &nae) {
+		t.Errorf("expected not allowed error, got '%v'", err)
+	}
+	var nfe *lib_model.NotFoundError
+	if err = h.Remove(context.Background(), "unknown"); !errors.As(err, &nfe) {
+		t.Errorf("expected not found error, got '%v'", err)
+	}
+	if err = h.Remove(context.Background(), id); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := h.getLog(id); ok {
+		t.Error("expected removed log")
+	}
+	if err = h.Remove(context.Background(), id); !errors.As(err, &nfe) {
+		t.Errorf("expected not found error, got '%v'", err)
+	}
+	h2 := newTestRegHandler(t, confPath)
+	if len(h2.regLogs) != 0 {
+		t.Errorf("expected no registered logs, got %d", len(h2.regLogs))
+	}
+	if _, ok := h2.getLog(util.GenHash("/var/log/app/defined.log")); !ok {
+		t.Error("expected defined log")
+	}
+}
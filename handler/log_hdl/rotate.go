@@ -0,0 +1,177 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"io"
+	"os"
+	"strconv"
+)
+
+// Rotate compresses log files exceeding their maximum size and removes generations exceeding the maximum number of files.
+func (h *Handler) Rotate(ctx context.Context) error {
+	h.rotMu.Lock()
+	defer h.rotMu.Unlock()
+	var errs []error
+	for _, log := range h.getAllLogs() {
+		if ctx.Err() != nil {
+			return lib_model.NewInternalError(ctx.Err())
+		}
+		if log.MaxSize <= 0 {
+			continue
+		}
+		rotated, err := rotate(log)
+		if err != nil {
+			util.Logger.Errorf("%s rotating '%s' failed: %s", logPrefix, log.Path, err)
+			errs = append(errs, fmt.Errorf("rotating '%s' failed: %s", log.Path, err))
+			continue
+		}
+		if rotated {
+			util.Logger.Debugf("%s rotated '%s'", logPrefix, log.Path)
+		}
+	}
+	if len(errs) > 0 {
+		return lib_model.NewInternalError(errors.Join(errs...))
+	}
+	return nil
+}
+
+// NeedsRotation reports whether at least one log file exceeds its maximum size.
+func (h *Handler) NeedsRotation() bool {
+	for _, log := range h.getAllLogs() {
+		if log.MaxSize <= 0 {
+			continue
+		}
+		if info, err := os.Stat(log.Path); err == nil && info.Size() > log.MaxSize {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *Handler) getAllLogs() []Log {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	var logs []Log
+	for _, log := range h.logs {
+		logs = append(logs, log)
+	}
+	for id, log := range h.regLogs {
+		if _, ok := h.logs[id]; !ok {
+			logs = append(logs, log)
+		}
+	}
+	return logs
+}
+
+func rotate(log Log) (bool, error) {
+	info, err := os.Stat(log.Path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if info.Size() <= log.MaxSize {
+		return false, nil
+	}
+	if err = shiftGenerations(log.Path, log.MaxFiles); err != nil {
+		return false, err
+	}
+	dst := genPath(log.Path, 1)
+	if !log.MoveCreate {
+		if err = compress(log.Path, dst); err != nil {
+			return false, err
+		}
+		return true, os.Truncate(log.Path, 0)
+	}
+	tmp := log.Path + ".rotate"
+	if err = os.Rename(log.Path, tmp); err != nil {
+		return false, err
+	}
+	if err = compress(tmp, dst); err != nil {
+		// the file is only moved back if the writer did not create a new one in the meantime
+		if _, err2 := os.Stat(log.Path); os.IsNotExist(err2) {
+			if err2 = os.Rename(tmp, log.Path); err2 != nil {
+				util.Logger.Errorf("%s restoring '%s' failed: %s", logPrefix, log.Path, err2)
+			}
+		}
+		return false, err
+	}
+	return true, os.Remove(tmp)
+}
+
+// shiftGenerations increments the number of each compressed generation and removes generations exceeding maxFiles.
+func shiftGenerations(p string, maxFiles int) error {
+	n := 0
+	for {
+		if _, err := os.Stat(genPath(p, n+1)); err != nil {
+			if os.IsNotExist(err) {
+				break
+			}
+			return err
+		}
+		n++
+	}
+	for ; n > 0; n-- {
+		if maxFiles > 0 && n+1 > maxFiles {
+			if err := os.Remove(genPath(p, n)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := os.Rename(genPath(p, n), genPath(p, n+1)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compress(src, dst string) error {
+	sFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer sFile.Close()
+	dFile, err := os.Create(dst + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(dst + ".tmp")
+	defer dFile.Close()
+	gw := gzip.NewWriter(dFile)
+	if _, err = io.Copy(gw, sFile); err != nil {
+		return err
+	}
+	if err = gw.Close(); err != nil {
+		return err
+	}
+	if err = dFile.Close(); err != nil {
+		return err
+	}
+	return os.Rename(dst+".tmp", dst)
+}
+
+func genPath(p string, n int) string {
+	return p + "." + strconv.FormatInt(int64(n), 10) + gzipExt
+}
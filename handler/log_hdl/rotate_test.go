@@ -0,0 +1,200 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"testing"
+)
+
+func readGzip(t *testing.T, p string) string {
+	t.Helper()
+	file, err := os.Open(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gr, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := io.ReadAll(gr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func writeFile(t *testing.T, p, content string) {
+	t.Helper()
+	if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func exists(p string) bool {
+	_, err := os.Stat(p)
+	return err == nil
+}
+
+func TestShiftGenerations(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxFiles int
+		want     map[int]string
+	}{
+		{name: "keep all", maxFiles: 0, want: map[int]string{2: "1", 3: "2", 4: "3"}},
+		{name: "remove exceeding", maxFiles: 3, want: map[int]string{2: "1", 3: "2"}},
+		{name: "single file", maxFiles: 1, want: map[int]string{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := path.Join(t.TempDir(), "test.log")
+			for _, n := range []int{1, 2, 3} {
+				if err := os.WriteFile(p+".tmp", []byte(string(rune('0'+n))), 0644); err != nil {
+					t.Fatal(err)
+				}
+				if err := compress(p+".tmp", genPath(p, n)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := shiftGenerations(p, tc.maxFiles); err != nil {
+				t.Fatal(err)
+			}
+			for n := 1; n <= 4; n++ {
+				want, ok := tc.want[n]
+				if !ok {
+					if exists(genPath(p, n)) {
+						t.Errorf("unexpected generation %d", n)
+					}
+					continue
+				}
+				if got := readGzip(t, genPath(p, n)); got != want {
+					t.Errorf("generation %d: expected '%s', got '%s'", n, want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	content := strings.Repeat("line\n", 4)
+	for name, moveCreate := range map[string]bool{"copy truncate": false, "move create": true} {
+		t.Run(name, func(t *testing.T) {
+			p := path.Join(t.TempDir(), "test.log")
+			log := Log{Path: p, MaxSize: 10, MaxFiles: 2, MoveCreate: moveCreate}
+			rotated, err := rotate(log)
+			if err != nil || rotated {
+				t.Fatalf("missing file: expected no rotation, got %v %v", rotated, err)
+			}
+			writeFile(t, p, "line\n")
+			if rotated, err = rotate(log); err != nil || rotated {
+				t.Fatalf("small file: expected no rotation, got %v %v", rotated, err)
+			}
+			for i, c := range []string{"a", "b", "c"} {
+				f, err := os.OpenFile(p, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, err = f.WriteString(c + content)
+				f.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if rotated, err = rotate(log); err != nil || !rotated {
+					t.Fatalf("rotation %d: expected rotation, got %v %v", i, rotated, err)
+				}
+				if info, err := os.Stat(p); moveCreate {
+					if !os.IsNotExist(err) {
+						t.Errorf("expected moved file, got %v", err)
+					}
+				} else if err != nil || info.Size() != 0 {
+					t.Errorf("expected truncated file, got %v", err)
+				}
+				if exists(p + ".rotate") {
+					t.Error("unexpected temporary file")
+				}
+			}
+			if got := readGzip(t, genPath(p, 1)); !strings.HasPrefix(got, "c") {
+				t.Errorf("expected newest generation first, got '%s'", got)
+			}
+			if got := readGzip(t, genPath(p, 2)); !strings.HasPrefix(got, "b") {
+				t.Errorf("expected second newest generation, got '%s'", got)
+			}
+			if exists(genPath(p, 3)) {
+				t.Error("expected removal of generations exceeding max files")
+			}
+		})
+	}
+}
+
+func TestRotateCompressionFailure(t *testing.T) {
+	content := strings.Repeat("line\n", 4)
+	for name, moveCreate := range map[string]bool{"copy truncate": false, "move create": true} {
+		t.Run(name, func(t *testing.T) {
+			p := path.Join(t.TempDir(), "test.log")
+			writeFile(t, p, content)
+			// blocks creation of the temporary archive file
+			if err := os.Mkdir(genPath(p, 1)+".tmp", 0755); err != nil {
+				t.Fatal(err)
+			}
+			rotated, err := rotate(Log{Path: p, MaxSize: 10, MoveCreate: moveCreate})
+			if err == nil || rotated {
+				t.Fatalf("expected error, got %v %v", rotated, err)
+			}
+			b, err := os.ReadFile(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != content {
+				t.Errorf("expected original content, got '%s'", b)
+			}
+			if exists(p + ".rotate") {
+				t.Error("unexpected temporary file")
+			}
+			if exists(genPath(p, 1)) {
+				t.Error("unexpected archive")
+			}
+		})
+	}
+}
+
+func TestNeedsRotation(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, path.Join(dir, "a.log"), strings.Repeat("a", 20))
+	writeFile(t, path.Join(dir, "b.log"), strings.Repeat("b", 5))
+	h := &Handler{
+		logs: map[string]Log{
+			"a": {Path: path.Join(dir, "a.log")},
+			"b": {Path: path.Join(dir, "b.log"), MaxSize: 10},
+		},
+		regLogs: map[string]Log{
+			"c": {Path: path.Join(dir, "c.log"), MaxSize: 10},
+		},
+	}
+	if h.NeedsRotation() {
+		t.Error("expected no rotation")
+	}
+	h.regLogs["a2"] = Log{Path: path.Join(dir, "a.log"), MaxSize: 10}
+	if !h.NeedsRotation() {
+		t.Error("expected rotation")
+	}
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package log_hdl
+
+import (
+	"context"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"os"
+	"time"
+)
+
+const logPrefix = "[log-hdl]"
+
+func (h *Handler) Start(ctx context.Context, interval time.Duration) {
+	go h.run(ctx, interval)
+}
+
+func (h *Handler) Running() bool {
+	h.loopMu.RLock()
+	defer h.loopMu.RUnlock()
+	return h.running
+}
+
+func (h *Handler) Wait() {
+	<-h.dChan
+}
+
+func (h *Handler) run(ctx context.Context, interval time.Duration) {
+	h.loopMu.Lock()
+	h.running = true
+	h.loopMu.Unlock()
+	modTime := getModTime(h.confPath)
+	timer := time.NewTimer(interval)
+	loop := true
+	for loop {
+		select {
+		case <-timer.C:
+			if mt := getModTime(h.confPath); !mt.Equal(modTime) {
+				modTime = mt
+				if err := h.Reload(); err != nil {
+					util.Logger.Errorf("%s reloading config failed: %s", logPrefix, err)
+				} else {
+					util.Logger.Infof("%s config reloaded", logPrefix)
+				}
+			}
+			timer.Reset(interval)
+		case <-ctx.Done():
+			loop = false
+			break
+		}
+	}
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
+		}
+	}
+	h.loopMu.Lock()
+	h.running = false
+	h.loopMu.Unlock()
+	h.dChan <- struct{}{}
+}
+
+func getModTime(p string) time.Time {
+	info, err := os.Stat(p)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,239 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"net"
+	"os"
+	"path"
+	"strings"
+)
+
+const (
+	sshaPrefix   = "{SSHA}"
+	htpasswdExt  = ".htpasswd"
+	authRealm    = "\"Restricted\""
+	saltLen      = 8
+	allAddresses = "all"
+)
+
+type authConfig struct {
+	htpasswdPath    string
+	authRequestPath string
+}
+
+func checkAuth(auth *lib_model.AuthConfig, authConf authConfig) error {
+	if auth == nil {
+		return nil
+	}
+	for _, addr := range append(auth.Allow, auth.Deny...) {
+		if err := checkAddress(addr); err != nil {
+			return err
+		}
+	}
+	if len(auth.BasicAuth) > 0 && authConf.htpasswdPath == "" {
+		return lib_model.NewInvalidInputError(errors.New("basic auth not supported"))
+	}
+	for user := range auth.BasicAuth {
+		if user == "" || strings.ContainsAny(user, ":\n") {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid user '%s'", user))
+		}
+	}
+	if auth.AuthRequest && authConf.authRequestPath == "" {
+		return lib_model.NewInvalidInputError(errors.New("auth request not supported"))
+	}
+	return nil
+}
+
+func checkAddress(addr string) error {
+	if addr == allAddresses || net.ParseIP(addr) != nil {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(addr); err != nil {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid address '%s'", addr))
+	}
+	return nil
+}
+
+// hashPasswords returns a copy of the auth config with plain text passwords replaced by salted SHA-1 hashes. Empty
+// passwords keep the hash of the same user in the previous auth config.
+func hashPasswords(auth, prevAuth *lib_model.AuthConfig) (*lib_model.AuthConfig, error) {
+	if auth == nil || len(auth.BasicAuth) == 0 {
+		return auth, nil
+	}
+	authCopy := *auth
+	authCopy.BasicAuth = make(map[string]string)
+	for user, password := range auth.BasicAuth {
+		if password == "" {
+			if prevAuth == nil || prevAuth.BasicAuth[user] == "" {
+				return nil, lib_model.NewInvalidInputError(fmt.Errorf("missing password for user '%s'", user))
+			}
+			authCopy.BasicAuth[user] = prevAuth.BasicAuth[user]
+			continue
+		}
+		if strings.HasPrefix(password, sshaPrefix) {
+			authCopy.BasicAuth[user] = password
+			continue
+		}
+		hash, err := hashPassword(password)
+		if err != nil {
+			return nil, lib_model.NewInternalError(err)
+		}
+		authCopy.BasicAuth[user] = hash
+	}
+	return &authCopy, nil
+}
+
+// stripCredentials returns a copy of the endpoint with basic auth passwords removed, so only users remain.
+func stripCredentials(e lib_model.Endpoint) lib_model.Endpoint {
+	if e.Auth == nil || len(e.Auth.BasicAuth) == 0 {
+		return e
+	}
+	authCopy := *e.Auth
+	authCopy.BasicAuth = make(map[string]string)
+	for user := range e.Auth.BasicAuth {
+		authCopy.BasicAuth[user] = ""
+	}
+	e.Auth = &authCopy
+	return e
+}
+
+// redactComments removes basic auth passwords from the endpoints stored in the comments of location and server blocks.
+func redactComments(directives []config.IDirective) error {
+	for _, directive := range directives {
+		comment := directive.GetComment()
+		if (directive.GetName() != locationDirective && directive.GetName() != serverDirective) || len(comment) == 0 {
+			continue
+		}
+		e, err := getEndpoint(comment[0], nil)
+		if err != nil {
+			return err
+		}
+		e.Endpoint = stripCredentials(e.Endpoint)
+		cmt, err := e.GenComment()
+		if err != nil {
+			return err
+		}
+		directive.SetComment([]string{cmt})
+	}
+	return nil
+}
+
+func hashPassword(password string) (string, error) {
+	salt := make([]byte, saltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	hash := sha1.New()
+	hash.Write([]byte(password))
+	hash.Write(salt)
+	return sshaPrefix + base64.StdEncoding.EncodeToString(append(hash.Sum(nil), salt...)), nil
+}
+
+func genHtpasswd(users map[string]string) []byte {
+	var sb strings.Builder
+	for _, user := range sortedKeys(users) {
+		sb.WriteString(user + ":" + users[user] + "\n")
+	}
+	return []byte(sb.String())
+}
+
+// getHtpasswdFilePath returns a path that changes with the content of the file, so updated credentials only take
+// effect after a successful reload.
+func getHtpasswdFilePath(e endpoint, htpasswdPath string) string {
+	return path.Join(htpasswdPath, e.ID+"_"+util.GenHash(string(genHtpasswd(e.Auth.BasicAuth)))+htpasswdExt)
+}
+
+func getAuthDirectives(e endpoint, authConf authConfig) []config.IDirective {
+	var directives []config.IDirective
+	if e.Auth == nil {
+		return directives
+	}
+	for _, addr := range e.Auth.Deny {
+		directives = append(directives, newDirective(denyDirective, []string{addr}, nil, nil))
+	}
+	for _, addr := range e.Auth.Allow {
+		directives = append(directives, newDirective(allowDirective, []string{addr}, nil, nil))
+	}
+	if len(e.Auth.Allow) > 0 {
+		directives = append(directives, newDirective(denyDirective, []string{allAddresses}, nil, nil))
+	}
+	if len(e.Auth.BasicAuth) > 0 {
+		directives = append(directives, newDirective(authBasicDirective, []string{authRealm}, nil, nil))
+		directives = append(directives, newDirective(authBasicUserFileDirective, []string{getHtpasswdFilePath(e, authConf.htpasswdPath)}, nil, nil))
+	}
+	if e.Auth.AuthRequest {
+		directives = append(directives, newDirective(authRequestDirective, []string{authConf.authRequestPath}, nil, nil))
+	}
+	return directives
+}
+
+func writeHtpasswdFiles(endpoints map[string]endpoint, htpasswdPath string) error {
+	if htpasswdPath == "" {
+		return nil
+	}
+	for _, e := range endpoints {
+		if e.Auth == nil || len(e.Auth.BasicAuth) == 0 {
+			continue
+		}
+		p := getHtpasswdFilePath(e, htpasswdPath)
+		if _, err := os.Stat(p); err == nil {
+			continue
+		}
+		if err := os.WriteFile(p, genHtpasswd(e.Auth.BasicAuth), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeStaleHtpasswdFiles removes htpasswd files not referenced by any endpoint.
+func removeStaleHtpasswdFiles(endpoints map[string]endpoint, htpasswdPath string) {
+	if htpasswdPath == "" {
+		return
+	}
+	files := make(map[string]struct{})
+	for _, e := range endpoints {
+		if e.Auth != nil && len(e.Auth.BasicAuth) > 0 {
+			files[getHtpasswdFilePath(e, htpasswdPath)] = struct{}{}
+		}
+	}
+	dirEntries, err := os.ReadDir(htpasswdPath)
+	if err != nil {
+		util.Logger.Error(err)
+		return
+	}
+	for _, entry := range dirEntries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), htpasswdExt) {
+			continue
+		}
+		p := path.Join(htpasswdPath, entry.Name())
+		if _, ok := files[p]; !ok {
+			if err = os.Remove(p); err != nil {
+				util.Logger.Error(err)
+			}
+		}
+	}
+}
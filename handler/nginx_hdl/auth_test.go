@@ -0,0 +1,81 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"encoding/base64"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"strings"
+	"testing"
+)
+
+func TestHashPasswords(t *testing.T) {
+	prev, err := hashPasswords(&lib_model.AuthConfig{BasicAuth: map[string]string{"a": "secret"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(prev.BasicAuth["a"], sshaPrefix) {
+		t.Errorf("password not hashed: '%s'", prev.BasicAuth["a"])
+	}
+	auth, err := hashPasswords(&lib_model.AuthConfig{BasicAuth: map[string]string{"a": ""}}, prev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth.BasicAuth["a"] != prev.BasicAuth["a"] {
+		t.Error("empty password did not keep previous hash")
+	}
+	if _, err = hashPasswords(&lib_model.AuthConfig{BasicAuth: map[string]string{"b": ""}}, prev); err == nil {
+		t.Error("expected error for empty password of new user")
+	}
+}
+
+func TestRedactComments(t *testing.T) {
+	auth, err := hashPasswords(&lib_model.AuthConfig{BasicAuth: map[string]string{"a": "secret"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := newEndpoint(lib_model.Endpoint{
+		Type:         lib_model.AliasEndpoint,
+		EndpointBase: lib_model.EndpointBase{Ref: "ref", Host: "host", ExtPath: "test", Auth: auth},
+	}, testTemplates(t))
+	if stripCredentials(e.Endpoint).Auth.BasicAuth["a"] != "" || e.Auth.BasicAuth["a"] == "" {
+		t.Error("credentials not stripped from copy")
+	}
+	directives, err := getDirectives(map[string]endpoint{e.ID: e}, authConfig{htpasswdPath: "/htpasswd"}, responseConfig{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = redactComments(directives); err != nil {
+		t.Fatal(err)
+	}
+	var n int
+	for _, d := range directives {
+		for _, c := range d.GetComment() {
+			n++
+			b, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(c, "#"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if strings.Contains(string(b), sshaPrefix) {
+				t.Errorf("password hash in comment: %s", b)
+			}
+		}
+	}
+	if n == 0 {
+		t.Error("no endpoint comments rendered")
+	}
+}
@@ -19,16 +19,45 @@ package nginx_hdl
 import lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
 
 const (
-	locationDirective         = "location"
-	rewriteDirective          = "rewrite"
-	proxyPassDirective        = "proxy_pass"
-	setDirective              = "set"
-	proxyHttpVerDirective     = "proxy_http_version"
-	proxySetHeaderDirective   = "proxy_set_header"
-	proxyReadTimeoutDirective = "proxy_read_timeout"
-	subFilterDirective        = "sub_filter"
-	subFilterOnceDirective    = "sub_filter_once"
-	subFilterTypesDirective   = "sub_filter_types"
+	locationDirective            = "location"
+	rewriteDirective             = "rewrite"
+	proxyPassDirective           = "proxy_pass"
+	setDirective                 = "set"
+	proxyHttpVerDirective        = "proxy_http_version"
+	proxySetHeaderDirective      = "proxy_set_header"
+	proxyReadTimeoutDirective    = "proxy_read_timeout"
+	proxyConnectTimeoutDirective = "proxy_connect_timeout"
+	proxySendTimeoutDirective    = "proxy_send_timeout"
+	proxyBufferingDirective      = "proxy_buffering"
+	proxyReqBufferingDirective   = "proxy_request_buffering"
+	proxyHideHeaderDirective     = "proxy_hide_header"
+	addHeaderDirective           = "add_header"
+	subFilterDirective           = "sub_filter"
+	subFilterOnceDirective       = "sub_filter_once"
+	subFilterTypesDirective      = "sub_filter_types"
+	allowDirective               = "allow"
+	denyDirective                = "deny"
+	authBasicDirective           = "auth_basic"
+	authBasicUserFileDirective   = "auth_basic_user_file"
+	authRequestDirective         = "auth_request"
+	clientMaxBodySizeDirective   = "client_max_body_size"
+	limitReqDirective            = "limit_req"
+	limitConnDirective           = "limit_conn"
+	limitReqZoneDirective        = "limit_req_zone"
+	limitConnZoneDirective       = "limit_conn_zone"
+	upstreamDirective            = "upstream"
+	serverDirective              = "server"
+	leastConnDirective           = "least_conn"
+	ipHashDirective              = "ip_hash"
+	listenDirective              = "listen"
+	serverNameDirective          = "server_name"
+	returnDirective              = "return"
+	defaultTypeDirective         = "default_type"
+	errorPageDirective           = "error_page"
+	internalDirective            = "internal"
+	aliasDirective               = "alias"
+	zoneDirective                = "zone"
+	resolverDirective            = "resolver"
 )
 
 const (
@@ -54,6 +83,14 @@ const (
 	AliasLocationTmpl
 	AliasRewriteTmpl
 	AliasProxyPassTmpl
+	SubdomainServerNameTmpl
+	SubdomainRewriteTmpl
+	SubdomainProxyPassTmpl
+	SubdomainAliasServerNameTmpl
+	SubdomainAliasRewriteTmpl
+	SubdomainAliasProxyPassTmpl
+	RedirectLocationTmpl
+	StaticLocationTmpl
 )
 
 var endpointTypeMap = map[int]map[int]int{
@@ -71,4 +108,20 @@ var endpointTypeMap = map[int]map[int]int{
 		rewriteTmpl:   AliasRewriteTmpl,
 		proxyPassTmpl: AliasProxyPassTmpl,
 	},
+	lib_model.SubdomainEndpoint: {
+		locationTmpl:  SubdomainServerNameTmpl,
+		rewriteTmpl:   SubdomainRewriteTmpl,
+		proxyPassTmpl: SubdomainProxyPassTmpl,
+	},
+	lib_model.SubdomainAliasEndpoint: {
+		locationTmpl:  SubdomainAliasServerNameTmpl,
+		rewriteTmpl:   SubdomainAliasRewriteTmpl,
+		proxyPassTmpl: SubdomainAliasProxyPassTmpl,
+	},
+	lib_model.RedirectEndpoint: {
+		locationTmpl: RedirectLocationTmpl,
+	},
+	lib_model.StaticEndpoint: {
+		locationTmpl: StaticLocationTmpl,
+	},
 }
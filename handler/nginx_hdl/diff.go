@@ -0,0 +1,171 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const diffContext = 3
+
+type diffLine struct {
+	kind byte
+	text string
+}
+
+// unifiedDiff returns the differences between a and b in unified format or an empty string if both are equal.
+func unifiedDiff(a, b, nameA, nameB string) string {
+	if a == b {
+		return ""
+	}
+	lines := diffLines(splitLines(a), splitLines(b))
+	var sb strings.Builder
+	sb.WriteString("--- " + nameA + "\n")
+	sb.WriteString("+++ " + nameB + "\n")
+	aPos := make([]int, len(lines)+1)
+	bPos := make([]int, len(lines)+1)
+	for i, l := range lines {
+		aPos[i+1], bPos[i+1] = aPos[i], bPos[i]
+		if l.kind != '+' {
+			aPos[i+1]++
+		}
+		if l.kind != '-' {
+			bPos[i+1]++
+		}
+	}
+	for i := 0; i < len(lines); {
+		if lines[i].kind == ' ' {
+			i++
+			continue
+		}
+		start := max(i-diffContext, 0)
+		end := i
+		for j := i; j < len(lines) && j-end <= 2*diffContext+1; j++ {
+			if lines[j].kind != ' ' {
+				end = j
+			}
+		}
+		end = min(end+diffContext+1, len(lines))
+		aCount, bCount := aPos[end]-aPos[start], bPos[end]-bPos[start]
+		sb.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(aPos[start], aCount), hunkRange(bPos[start], bCount)))
+		for _, l := range lines[start:end] {
+			sb.WriteByte(l.kind)
+			sb.WriteString(l.text + "\n")
+		}
+		i = end
+	}
+	return sb.String()
+}
+
+func hunkRange(pos, count int) string {
+	if count > 0 {
+		pos++
+	}
+	return fmt.Sprintf("%d,%d", pos, count)
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimSuffix(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// diffLines generates an edit script based on a longest common subsequence of a and b. The subsequence is determined
+// with Hirschberg's algorithm to keep memory usage linear.
+func diffLines(a, b []string) []diffLine {
+	return appendDiff(nil, a, b)
+}
+
+func appendDiff(lines []diffLine, a, b []string) []diffLine {
+	p := 0
+	for p < len(a) && p < len(b) && a[p] == b[p] {
+		p++
+	}
+	lines = appendLines(lines, ' ', a[:p])
+	a, b = a[p:], b[p:]
+	s := 0
+	for s < len(a) && s < len(b) && a[len(a)-1-s] == b[len(b)-1-s] {
+		s++
+	}
+	suffix := a[len(a)-s:]
+	a, b = a[:len(a)-s], b[:len(b)-s]
+	switch {
+	case len(a) == 0:
+		lines = appendLines(lines, '+', b)
+	case len(b) == 0:
+		lines = appendLines(lines, '-', a)
+	case len(a) == 1:
+		if j := slices.Index(b, a[0]); j >= 0 {
+			lines = appendLines(lines, '+', b[:j])
+			lines = appendLines(lines, ' ', a)
+			lines = appendLines(lines, '+', b[j+1:])
+		} else {
+			lines = appendLines(lines, '-', a)
+			lines = appendLines(lines, '+', b)
+		}
+	default:
+		mid := len(a) / 2
+		fwd := lcsLengths(a[:mid], b, false)
+		bwd := lcsLengths(a[mid:], b, true)
+		k, best := 0, -1
+		for j := 0; j <= len(b); j++ {
+			if l := fwd[j] + bwd[len(b)-j]; l > best {
+				k, best = j, l
+			}
+		}
+		lines = appendDiff(lines, a[:mid], b[:k])
+		lines = appendDiff(lines, a[mid:], b[k:])
+	}
+	return appendLines(lines, ' ', suffix)
+}
+
+// lcsLengths returns the lengths of the longest common subsequences of a and every prefix of b. If reverse is set
+// both sequences are processed back to front.
+func lcsLengths(a, b []string, reverse bool) []int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for i := range a {
+		x := a[i]
+		if reverse {
+			x = a[len(a)-1-i]
+		}
+		for j := range b {
+			y := b[j]
+			if reverse {
+				y = b[len(b)-1-j]
+			}
+			if x == y {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+func appendLines(lines []diffLine, kind byte, texts []string) []diffLine {
+	for _, text := range texts {
+		lines = append(lines, diffLine{kind: kind, text: text})
+	}
+	return lines
+}
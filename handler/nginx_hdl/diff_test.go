@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestDiffLines(t *testing.T) {
+	lcsLen := func(a, b []string) int {
+		return lcsLengths(a, b, false)[len(b)]
+	}
+	rnd := rand.New(rand.NewSource(1))
+	genLines := func() []string {
+		lines := make([]string, rnd.Intn(20))
+		for i := range lines {
+			lines[i] = string(rune('a' + rnd.Intn(4)))
+		}
+		return lines
+	}
+	for n := 0; n < 500; n++ {
+		a, b := genLines(), genLines()
+		var gotA, gotB []string
+		common := 0
+		for _, l := range diffLines(a, b) {
+			switch l.kind {
+			case ' ':
+				gotA, gotB = append(gotA, l.text), append(gotB, l.text)
+				common++
+			case '-':
+				gotA = append(gotA, l.text)
+			case '+':
+				gotB = append(gotB, l.text)
+			}
+		}
+		if !slices.Equal(gotA, a) || !slices.Equal(gotB, b) {
+			t.Fatalf("edit script of %v and %v does not reproduce inputs: %v %v", a, b, gotA, gotB)
+		}
+		if want := lcsLen(a, b); common != want {
+			t.Fatalf("expected %d common lines for %v and %v, got %d", want, a, b, common)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n"
+	b := "1\n2\n3\n4\nfive\n6\n7\n8\n9\n10\n11\n"
+	want := "--- a\n+++ b\n@@ -2,9 +2,10 @@\n 2\n 3\n 4\n-5\n+five\n 6\n 7\n 8\n 9\n 10\n+11\n"
+	if got := unifiedDiff(a, b, "a", "b"); got != want {
+		t.Errorf("expected\n%s\ngot\n%s", want, got)
+	}
+	if got := unifiedDiff(a, a, "a", "b"); got != "" {
+		t.Errorf("expected empty diff, got '%s'", got)
+	}
+}
@@ -73,10 +73,14 @@ func (e endpoint) GetSetValue() string {
 }
 
 func genProxyPassValue(e lib_model.Endpoint, templates map[int]string) string {
-	template := templates[endpointTypeMap[e.Type][proxyPassTmpl]]
+	tmplID, ok := endpointTypeMap[e.Type][proxyPassTmpl]
+	if !ok {
+		return ""
+	}
+	template := templates[tmplID]
 	template = strings.Replace(template, varPlaceholder, "$v"+e.ID, -1)
 	var port string
-	if e.Port != nil && *e.Port != 80 {
+	if e.Upstream == nil && e.Port != nil && *e.Port != 80 {
 		port = ":" + strconv.FormatInt(int64(*e.Port), 10)
 	}
 	template = strings.Replace(template, portPlaceholder, port, -1)
@@ -90,11 +94,18 @@ func genLocationValue(eBase lib_model.EndpointBase, eType lib_model.EndpointType
 }
 
 func genSetValue(e lib_model.Endpoint) string {
+	if e.Upstream != nil {
+		return fmt.Sprintf("$v%s %s", e.ID, genUpstreamName(e))
+	}
 	return fmt.Sprintf("$v%s %s", e.ID, e.Host)
 }
 
 func genRewriteValue(e lib_model.Endpoint, eType lib_model.EndpointType, templates map[int]string) string {
-	template := templates[endpointTypeMap[eType][rewriteTmpl]]
+	tmplID, ok := endpointTypeMap[eType][rewriteTmpl]
+	if !ok {
+		return ""
+	}
+	template := templates[tmplID]
 	template = strings.Replace(template, refPlaceholder, e.Ref, -1)
 	return strings.Replace(template, pathPlaceholder, e.ExtPath, -1)
 }
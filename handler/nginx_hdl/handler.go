@@ -29,27 +29,92 @@ import (
 	"github.com/tufanbarisyildirim/gonginx/parser"
 	"io"
 	"os"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
+var headerNameRegex = regexp.MustCompile("^[A-Za-z0-9!#$%&'*+.^_`|~-]+$")
+
+// Config holds the paths and settings of the endpoint handler. Optional features are disabled if their path is empty.
+type Config struct {
+	ConfPath         string // location blocks, included in the server context
+	HttpConfPath     string // limit zones and subdomain server blocks, included in the http context
+	UpstreamConfPath string // upstream blocks, included in the http context
+	Resolver         string // address of the DNS server used to resolve hosts at runtime, empty -> resolver of the including context
+	ServerListen     string // listen parameters of subdomain server blocks, e.g. "80" or "443 ssl"
+	Templates        map[int]string
+	HistoryPath      string
+	HistorySize      int
+	HtpasswdPath     string
+	AuthRequestPath  string
+	StaticPath       string // directory of files served by static response endpoints
+	MaintenancePage  string
+	StreamConfPath   string // stream server blocks, included in the stream context
+	StreamPortMin    int
+	StreamPortMax    int
+}
+
 type Handler struct {
-	ctrHdl    ContainerHandler
-	confPath  string
-	templates map[int]string
-	endpoints map[string]endpoint
-	m         sync.RWMutex
+	ctrHdl           ContainerHandler
+	confPath         string
+	templates        map[int]string
+	endpoints        map[string]endpoint
+	historyPath      string
+	historySize      int
+	history          []lib_model.EndpointConfigVersion
+	authConf         authConfig
+	httpConfPath     string
+	upstreamConfPath string
+	resolver         string
+	serverListen     string
+	respConf         responseConfig
+	streamConf       streamConfig
+	streams          map[string]lib_model.StreamEndpoint
+	m                sync.RWMutex
 }
 
-func New(containerHandler ContainerHandler, confPath string, templates map[int]string) *Handler {
+func New(containerHandler ContainerHandler, config Config) *Handler {
 	return &Handler{
-		ctrHdl:    containerHandler,
-		confPath:  confPath,
-		templates: templates,
+		ctrHdl:           containerHandler,
+		confPath:         config.ConfPath,
+		httpConfPath:     config.HttpConfPath,
+		upstreamConfPath: config.UpstreamConfPath,
+		resolver:         config.Resolver,
+		serverListen:     config.ServerListen,
+		respConf: responseConfig{
+			staticPath:      config.StaticPath,
+			maintenancePage: config.MaintenancePage,
+		},
+		streamConf: streamConfig{
+			confPath: config.StreamConfPath,
+			portMin:  config.StreamPortMin,
+			portMax:  config.StreamPortMax,
+		},
+		templates:   config.Templates,
+		historyPath: config.HistoryPath,
+		historySize: config.HistorySize,
+		authConf: authConfig{
+			htpasswdPath:    config.HtpasswdPath,
+			authRequestPath: config.AuthRequestPath,
+		},
 	}
 }
 
 func (h *Handler) Init() error {
+	if err := checkTemplates(h.templates); err != nil {
+		return err
+	}
+	if h.respConf.maintenancePage != "" {
+		if _, err := os.Stat(h.respConf.maintenancePage); err != nil {
+			return err
+		}
+	}
 	_, err := os.Stat(h.confPath)
 	if err != nil {
 		if !os.IsNotExist(err) {
@@ -60,19 +125,79 @@ func (h *Handler) Init() error {
 			return err
 		}
 	}
-	p, err := parser.NewParser(h.confPath)
+	for _, p := range []string{h.httpConfPath, h.upstreamConfPath} {
+		if p == "" {
+			continue
+		}
+		if _, err = os.Stat(p); err != nil {
+			if !os.IsNotExist(err) {
+				return err
+			}
+			if err = os.WriteFile(p, nil, 0644); err != nil {
+				return err
+			}
+		}
+	}
+	conf, err := parseConfig(h.confPath)
+	if err != nil {
+		return err
+	}
+	endpoints, err := getEndpoints(conf.GetDirectives(), h.templates)
+	if err != nil {
+		return err
+	}
+	var httpConf *config.Config
+	if h.httpConfPath != "" {
+		httpConf, err = parseConfig(h.httpConfPath)
+		if err != nil {
+			return err
+		}
+		httpEndpoints, err := getEndpoints(httpConf.GetDirectives(), h.templates)
+		if err != nil {
+			return err
+		}
+		for id, e := range httpEndpoints {
+			endpoints[id] = e
+		}
+	}
+	h.endpoints, err = migrateEndpoints(endpoints, h.templates)
 	if err != nil {
 		return err
 	}
-	conf, err := p.Parse()
+	directives, err := getDirectives(h.endpoints, h.authConf, h.respConf)
 	if err != nil {
 		return err
 	}
-	h.endpoints, err = getEndpoints(conf.GetDirectives(), h.templates)
+	httpDirectives, err := getHttpDirectives(h.endpoints, h.authConf, h.respConf, h.serverListen, h.resolver)
 	if err != nil {
 		return err
 	}
-	return nil
+	if dumper.DumpBlock(newBlock(directives), dumper.IndentedStyle) != dumper.DumpConfig(conf, dumper.IndentedStyle) || (httpConf != nil && dumper.DumpBlock(newBlock(httpDirectives), dumper.IndentedStyle) != dumper.DumpConfig(httpConf, dumper.IndentedStyle)) {
+		util.Logger.Warning("endpoint config does not match templates, re-rendering endpoints ...")
+		if err = writeConfig(directives, h.confPath); err != nil {
+			return err
+		}
+		if h.httpConfPath != "" {
+			if err = writeConfig(httpDirectives, h.httpConfPath); err != nil {
+				return err
+			}
+		}
+	}
+	if err = h.initUpstreams(); err != nil {
+		return err
+	}
+	if h.authConf.htpasswdPath != "" {
+		if err = os.MkdirAll(h.authConf.htpasswdPath, 0775); err != nil {
+			return err
+		}
+		if err = writeHtpasswdFiles(h.endpoints, h.authConf.htpasswdPath); err != nil {
+			return err
+		}
+	}
+	if err = h.initStreams(); err != nil {
+		return err
+	}
+	return h.initHistory()
 }
 
 func (h *Handler) List(ctx context.Context, filter lib_model.EndpointFilter) (map[string]lib_model.Endpoint, error) {
@@ -85,7 +210,7 @@ func (h *Handler) List(ctx context.Context, filter lib_model.EndpointFilter) (ma
 			return nil, lib_model.NewInternalError(ctx.Err())
 		}
 		e.Location = e.GetLocationValue()
-		endpoints[id] = e.Endpoint
+		endpoints[id] = stripCredentials(e.Endpoint)
 	}
 	return endpoints, nil
 }
@@ -98,62 +223,63 @@ func (h *Handler) Get(_ context.Context, id string) (lib_model.Endpoint, error)
 		return lib_model.Endpoint{}, lib_model.NewNotFoundError(errors.New("endpoint not found"))
 	}
 	e.Location = e.GetLocationValue()
-	return e.Endpoint, nil
+	return stripCredentials(e.Endpoint), nil
 }
 
-func (h *Handler) Set(ctx context.Context, eBase lib_model.EndpointBase) error {
-	h.m.Lock()
-	defer h.m.Unlock()
-	if err := checkIntPath(eBase.IntPath); err != nil {
-		return err
-	}
-	if err := checkExtPath(eBase.ExtPath); err != nil {
-		return err
-	}
-	endpointsCopy := make(map[string]endpoint)
-	for id, e := range h.endpoints {
-		endpointsCopy[id] = e
-	}
-	ept := newEndpoint(lib_model.Endpoint{Type: lib_model.StandardEndpoint, EndpointBase: eBase}, h.templates)
-	if ept2, ok := endpointsCopy[ept.ID]; ok {
-		util.Logger.Warningf("endpoint '%+v' replaced by '%+v'", ept2.EndpointBase, ept.EndpointBase)
+// GetConfigs returns all generated config files by file name with basic auth passwords removed.
+func (h *Handler) GetConfigs(_ context.Context) (map[string][]byte, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	configs := make(map[string][]byte)
+	for _, p := range []string{h.confPath, h.httpConfPath, h.upstreamConfPath, h.streamConf.confPath} {
+		if p == "" {
+			continue
+		}
+		conf, err := parseConfig(p)
+		if err != nil {
+			return nil, lib_model.NewInternalError(err)
+		}
+		if err = redactComments(conf.GetDirectives()); err != nil {
+			return nil, lib_model.NewInternalError(err)
+		}
+		configs[path.Base(p)] = []byte(dumper.DumpConfig(conf, dumper.IndentedStyle))
 	}
-	endpointsCopy[ept.ID] = ept
-	return h.update(ctx, endpointsCopy)
+	return configs, nil
+}
+
+func (h *Handler) Set(ctx context.Context, eBase lib_model.EndpointBase, force bool) error {
+	return h.SetList(ctx, []lib_model.EndpointBase{eBase}, force)
 }
 
-func (h *Handler) SetList(ctx context.Context, eBaseSl []lib_model.EndpointBase) error {
+func (h *Handler) SetList(ctx context.Context, eBaseSl []lib_model.EndpointBase, force bool) error {
 	if len(eBaseSl) > 0 {
 		h.m.Lock()
 		defer h.m.Unlock()
-		endpointsCopy := make(map[string]endpoint)
-		for id, e := range h.endpoints {
-			endpointsCopy[id] = e
-		}
-		for _, eBase := range eBaseSl {
-			if err := checkIntPath(eBase.IntPath); err != nil {
-				return err
-			}
-			if err := checkExtPath(eBase.ExtPath); err != nil {
-				return err
-			}
-			ept := newEndpoint(lib_model.Endpoint{Type: lib_model.StandardEndpoint, EndpointBase: eBase}, h.templates)
-			if ept2, ok := endpointsCopy[ept.ID]; ok {
-				util.Logger.Warningf("endpoint '%+v' replaced by '%+v'", ept2.EndpointBase, ept.EndpointBase)
-			}
-			endpointsCopy[ept.ID] = ept
+		endpointsCopy, err := h.setEndpoints(eBaseSl, force, true)
+		if err != nil {
+			return err
 		}
 		return h.update(ctx, endpointsCopy)
 	}
 	return nil
 }
 
-func (h *Handler) AddAlias(ctx context.Context, id, path string) error {
-	return h.addAlias(ctx, id, path, lib_model.AliasEndpoint)
+func (h *Handler) SetListDryRun(_ context.Context, eBaseSl []lib_model.EndpointBase, force bool) (lib_model.EndpointChanges, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	endpointsCopy, err := h.setEndpoints(eBaseSl, force, false)
+	if err != nil {
+		return lib_model.EndpointChanges{}, err
+	}
+	return h.getChanges(endpointsCopy)
+}
+
+func (h *Handler) AddAlias(ctx context.Context, id, path string, force bool) error {
+	return h.addAlias(ctx, id, path, lib_model.AliasEndpoint, force)
 }
 
 func (h *Handler) AddDefaultGui(ctx context.Context, id string) error {
-	return h.addAlias(ctx, id, "", lib_model.DefaultGuiEndpoint)
+	return h.addAlias(ctx, id, "", lib_model.DefaultGuiEndpoint, true)
 }
 
 func (h *Handler) Remove(ctx context.Context, id string, restrictStd bool) error {
@@ -184,50 +310,101 @@ func (h *Handler) RemoveAll(ctx context.Context, filter lib_model.EndpointFilter
 	}
 	h.m.Lock()
 	defer h.m.Unlock()
-	filtered := filterEndpoints(h.endpoints, filter)
-	if len(filtered) == 0 {
-		return nil
+	endpointsCopy, err := h.removeEndpoints(filter, restrictStd)
+	if err != nil || endpointsCopy == nil {
+		return err
 	}
-	endpointsCopy := make(map[string]endpoint)
-	for id, e := range h.endpoints {
-		endpointsCopy[id] = e
+	return h.update(ctx, endpointsCopy)
+}
+
+func (h *Handler) RemoveAllDryRun(_ context.Context, filter lib_model.EndpointFilter, restrictStd bool) (lib_model.EndpointChanges, error) {
+	if restrictStd && filterEmpty(filter) {
+		return lib_model.EndpointChanges{}, nil
 	}
-	for id, e := range filtered {
-		if restrictStd && e.Type == lib_model.StandardEndpoint {
-			return lib_model.NewNotAllowedError(fmt.Errorf("remove endpoint '%s' not allowed", id))
-		}
-		delete(endpointsCopy, id)
-		aliases := h.getAliases(id)
-		for _, id2 := range aliases {
-			delete(endpointsCopy, id2)
-		}
+	h.m.RLock()
+	defer h.m.RUnlock()
+	endpointsCopy, err := h.removeEndpoints(filter, restrictStd)
+	if err != nil {
+		return lib_model.EndpointChanges{}, err
 	}
-	return h.update(ctx, endpointsCopy)
+	if endpointsCopy == nil {
+		return lib_model.EndpointChanges{}, nil
+	}
+	return h.getChanges(endpointsCopy)
 }
 
 func (h *Handler) update(ctx context.Context, endpoints map[string]endpoint) error {
-	directives, err := getDirectives(endpoints)
+	directives, err := getDirectives(endpoints, h.authConf, h.respConf)
 	if err != nil {
 		return lib_model.NewInternalError(err)
 	}
+	httpDirectives, err := getHttpDirectives(endpoints, h.authConf, h.respConf, h.serverListen, h.resolver)
+	if err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if err = writeHtpasswdFiles(endpoints, h.authConf.htpasswdPath); err != nil {
+		return lib_model.NewInternalError(err)
+	}
 	if ctx.Err() != nil {
 		return lib_model.NewInternalError(ctx.Err())
 	}
 	if err = writeConfig(directives, h.confPath); err != nil {
 		return lib_model.NewInternalError(err)
 	}
-	if err = h.ctrHdl.ExecCmd(ctx, []string{"nginx", "-s", "reload"}, true, nil, ""); err != nil {
-		e := copy(h.confPath+".bk", h.confPath)
-		if e != nil {
-			util.Logger.Error(e)
+	if h.httpConfPath != "" {
+		if err = writeConfig(httpDirectives, h.httpConfPath); err != nil {
+			restoreConfig(h.confPath)
+			return lib_model.NewInternalError(err)
 		}
-		return lib_model.NewInternalError(err)
 	}
+	if h.upstreamConfPath != "" {
+		if err = writeConfig(getUpstreamDirectives(endpoints, h.resolver), h.upstreamConfPath); err != nil {
+			restoreConfig(h.confPath)
+			if h.httpConfPath != "" {
+				restoreConfig(h.httpConfPath)
+			}
+			return lib_model.NewInternalError(err)
+		}
+	}
+	if err = h.reload(ctx); err != nil {
+		h.restoreConfigs()
+		return err
+	}
+	if err = h.addHistoryItem(ctx, endpoints); err != nil {
+		util.Logger.Errorf("adding endpoint config to history failed: %s", err)
+	}
+	removeStaleHtpasswdFiles(endpoints, h.authConf.htpasswdPath)
 	h.endpoints = endpoints
 	return nil
 }
 
-func (h *Handler) addAlias(ctx context.Context, pID, path string, eType lib_model.EndpointType) error {
+// reload tests the written config and reloads nginx. Only a failed config test is reported as invalid input, errors
+// executing the test are internal errors. Restoring the previous config on failure is up to the caller.
+func (h *Handler) reload(ctx context.Context) error {
+	if err := h.ctrHdl.ExecCmd(ctx, []string{"nginx", "-t"}, false, nil, ""); err != nil {
+		var execErr *util.ExecError
+		if errors.As(err, &execErr) && ctx.Err() == nil {
+			return lib_model.NewInvalidInputError(fmt.Errorf("config test failed: %s", strings.TrimSpace(execErr.Output)))
+		}
+		return lib_model.NewInternalError(err)
+	}
+	if err := h.ctrHdl.ExecCmd(ctx, []string{"nginx", "-s", "reload"}, true, nil, ""); err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	return nil
+}
+
+func (h *Handler) restoreConfigs() {
+	restoreConfig(h.confPath)
+	if h.httpConfPath != "" {
+		restoreConfig(h.httpConfPath)
+	}
+	if h.upstreamConfPath != "" {
+		restoreConfig(h.upstreamConfPath)
+	}
+}
+
+func (h *Handler) addAlias(ctx context.Context, pID, path string, eType lib_model.EndpointType, force bool) error {
 	h.m.Lock()
 	defer h.m.Unlock()
 	if err := checkExtPath(path); err != nil {
@@ -237,9 +414,12 @@ func (h *Handler) addAlias(ctx context.Context, pID, path string, eType lib_mode
 	if !ok {
 		return lib_model.NewNotFoundError(errors.New("endpoint not found"))
 	}
-	if e.Type != lib_model.StandardEndpoint {
+	if e.Type != lib_model.StandardEndpoint && e.Type != lib_model.SubdomainEndpoint {
 		return lib_model.NewInvalidInputError(errors.New("invalid parent type"))
 	}
+	if e.Type == lib_model.SubdomainEndpoint && eType == lib_model.AliasEndpoint {
+		eType = lib_model.SubdomainAliasEndpoint
+	}
 	endpointsCopy := make(map[string]endpoint)
 	for eID, e2 := range h.endpoints {
 		endpointsCopy[eID] = e2
@@ -248,15 +428,197 @@ func (h *Handler) addAlias(ctx context.Context, pID, path string, eType lib_mode
 	ept := newEndpoint(lib_model.Endpoint{
 		ParentID:     e.ID,
 		Type:         eType,
+		Maintenance:  e.Maintenance,
 		EndpointBase: e.EndpointBase,
 	}, h.templates)
+	if eType == lib_model.SubdomainAliasEndpoint {
+		if err := checkServerName(ept.GetLocationValue()); err != nil {
+			return err
+		}
+	}
 	if ept2, ok := endpointsCopy[ept.ID]; ok {
 		return lib_model.NewInvalidInputError(fmt.Errorf("duplicate endpoint '%s' & '%s' -> '%s'", ept.Ref, ept2.Ref, ept2.GetLocationValue()))
 	}
+	if !force {
+		if err := checkConflicts(ept, endpointsCopy); err != nil {
+			return err
+		}
+	}
 	endpointsCopy[ept.ID] = ept
 	return h.update(ctx, endpointsCopy)
 }
 
+func (h *Handler) setEndpoints(eBaseSl []lib_model.EndpointBase, force, logReplaced bool) (map[string]endpoint, error) {
+	endpointsCopy := make(map[string]endpoint)
+	for id, e := range h.endpoints {
+		endpointsCopy[id] = e
+	}
+	for _, eBase := range eBaseSl {
+		if err := checkIntPath(eBase.IntPath); err != nil {
+			return nil, err
+		}
+		if err := checkExtPath(eBase.ExtPath); err != nil {
+			return nil, err
+		}
+		if err := checkHealthCheck(eBase.HealthCheck); err != nil {
+			return nil, err
+		}
+		if err := checkAuth(eBase.Auth, h.authConf); err != nil {
+			return nil, err
+		}
+		if err := checkProxyConf(eBase.ProxyConf); err != nil {
+			return nil, err
+		}
+		if err := checkLimits(eBase.ProxyConf, h.httpConfPath); err != nil {
+			return nil, err
+		}
+		if err := checkUpstream(eBase.Upstream, h.upstreamConfPath); err != nil {
+			return nil, err
+		}
+		if err := checkResponse(eBase, h.respConf.staticPath); err != nil {
+			return nil, err
+		}
+		eType := lib_model.StandardEndpoint
+		if eBase.Subdomain {
+			if h.httpConfPath == "" {
+				return nil, lib_model.NewInvalidInputError(errors.New("subdomain endpoints not supported, http config path not set"))
+			}
+			eType = lib_model.SubdomainEndpoint
+		}
+		if rType, ok := getResponseType(eBase); ok {
+			eType = rType
+		}
+		ept := newEndpoint(lib_model.Endpoint{Type: eType, EndpointBase: eBase}, h.templates)
+		var prevAuth *lib_model.AuthConfig
+		if ept2, ok := endpointsCopy[ept.ID]; ok {
+			prevAuth = ept2.Auth
+		}
+		auth, err := hashPasswords(eBase.Auth, prevAuth)
+		if err != nil {
+			return nil, err
+		}
+		ept.Auth = auth
+		if eType == lib_model.SubdomainEndpoint {
+			if err = checkServerName(ept.GetLocationValue()); err != nil {
+				return nil, err
+			}
+		}
+		if !force {
+			if err := checkConflicts(ept, endpointsCopy); err != nil {
+				return nil, err
+			}
+		}
+		if ept2, ok := endpointsCopy[ept.ID]; ok {
+			if ept2.Type == ept.Type && ept2.ExtPath != ept.ExtPath {
+				return nil, lib_model.NewInvalidInputError(fmt.Errorf("location '%s' already used by endpoint '%s' (ref '%s', path '%s')", ept.GetLocationValue(), ept2.ID, ept2.Ref, ept2.ExtPath))
+			}
+			if logReplaced {
+				util.Logger.Warningf("endpoint '%+v' replaced by '%+v'", ept2.EndpointBase, ept.EndpointBase)
+			}
+			if !isResponse(ept) {
+				ept.Maintenance = ept2.Maintenance
+			}
+		}
+		endpointsCopy[ept.ID] = ept
+	}
+	return endpointsCopy, nil
+}
+
+// removeEndpoints returns a copy of the current endpoints without the filtered endpoints and their aliases or nil if
+// no endpoint matches the filter.
+func (h *Handler) removeEndpoints(filter lib_model.EndpointFilter, restrictStd bool) (map[string]endpoint, error) {
+	filtered := filterEndpoints(h.endpoints, filter)
+	if len(filtered) == 0 {
+		return nil, nil
+	}
+	endpointsCopy := make(map[string]endpoint)
+	for id, e := range h.endpoints {
+		endpointsCopy[id] = e
+	}
+	for id, e := range filtered {
+		if restrictStd && e.Type == lib_model.StandardEndpoint {
+			return nil, lib_model.NewNotAllowedError(fmt.Errorf("remove endpoint '%s' not allowed", id))
+		}
+		delete(endpointsCopy, id)
+		aliases := h.getAliases(id)
+		for _, id2 := range aliases {
+			delete(endpointsCopy, id2)
+		}
+	}
+	return endpointsCopy, nil
+}
+
+func (h *Handler) getChanges(endpoints map[string]endpoint) (lib_model.EndpointChanges, error) {
+	var changes lib_model.EndpointChanges
+	changes.Added, changes.Replaced, changes.Removed = h.getChangedIDs(endpoints)
+	oldDirectives, err := getDirectives(h.endpoints, h.authConf, h.respConf)
+	if err != nil {
+		return lib_model.EndpointChanges{}, lib_model.NewInternalError(err)
+	}
+	newDirectives, err := getDirectives(endpoints, h.authConf, h.respConf)
+	if err != nil {
+		return lib_model.EndpointChanges{}, lib_model.NewInternalError(err)
+	}
+	if err = redactComments(append(oldDirectives, newDirectives...)); err != nil {
+		return lib_model.EndpointChanges{}, lib_model.NewInternalError(err)
+	}
+	changes.Diff = unifiedDiff(
+		dumper.DumpBlock(newBlock(oldDirectives), dumper.IndentedStyle),
+		dumper.DumpBlock(newBlock(newDirectives), dumper.IndentedStyle),
+		h.confPath,
+		h.confPath,
+	)
+	if h.httpConfPath != "" {
+		oldHttpDirectives, err := getHttpDirectives(h.endpoints, h.authConf, h.respConf, h.serverListen, h.resolver)
+		if err != nil {
+			return lib_model.EndpointChanges{}, lib_model.NewInternalError(err)
+		}
+		newHttpDirectives, err := getHttpDirectives(endpoints, h.authConf, h.respConf, h.serverListen, h.resolver)
+		if err != nil {
+			return lib_model.EndpointChanges{}, lib_model.NewInternalError(err)
+		}
+		if err = redactComments(append(oldHttpDirectives, newHttpDirectives...)); err != nil {
+			return lib_model.EndpointChanges{}, lib_model.NewInternalError(err)
+		}
+		changes.Diff += unifiedDiff(
+			dumper.DumpBlock(newBlock(oldHttpDirectives), dumper.IndentedStyle),
+			dumper.DumpBlock(newBlock(newHttpDirectives), dumper.IndentedStyle),
+			h.httpConfPath,
+			h.httpConfPath,
+		)
+	}
+	if h.upstreamConfPath != "" {
+		changes.Diff += unifiedDiff(
+			dumper.DumpBlock(newBlock(getUpstreamDirectives(h.endpoints, h.resolver)), dumper.IndentedStyle),
+			dumper.DumpBlock(newBlock(getUpstreamDirectives(endpoints, h.resolver)), dumper.IndentedStyle),
+			h.upstreamConfPath,
+			h.upstreamConfPath,
+		)
+	}
+	return changes, nil
+}
+
+func (h *Handler) getChangedIDs(endpoints map[string]endpoint) (added, replaced, removed []string) {
+	added, replaced, removed = []string{}, []string{}, []string{}
+	for id, e := range endpoints {
+		e2, ok := h.endpoints[id]
+		if !ok {
+			added = append(added, id)
+		} else if !reflect.DeepEqual(e, e2) {
+			replaced = append(replaced, id)
+		}
+	}
+	for id := range h.endpoints {
+		if _, ok := endpoints[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(replaced)
+	sort.Strings(removed)
+	return
+}
+
 func (h *Handler) getAliases(pID string) []string {
 	var aIDs []string
 	for id, e := range h.endpoints {
@@ -267,26 +629,54 @@ func (h *Handler) getAliases(pID string) []string {
 	return aIDs
 }
 
-func getDirectives(endpoints map[string]endpoint) ([]config.IDirective, error) {
+// getDirectives returns the location blocks of all path based endpoints.
+func getDirectives(endpoints map[string]endpoint, authConf authConfig, respConf responseConfig) ([]config.IDirective, error) {
 	var directives []config.IDirective
-	for _, e := range endpoints {
+	for _, id := range sortedKeys(endpoints) {
+		e := endpoints[id]
+		if isSubdomain(e) {
+			continue
+		}
 		cmt, err := e.GenComment()
 		if err != nil {
 			return nil, err
 		}
-		var locDirectives []config.IDirective
-		locDirectives = append(locDirectives, newDirective(setDirective, []string{e.GetSetValue()}, nil, nil))
-		if e.Type != lib_model.DefaultGuiEndpoint {
-			locDirectives = append(locDirectives, newDirective(rewriteDirective, []string{e.GetRewriteValue()}, nil, nil))
-			locDirectives = append(locDirectives, getProxyDirectives(e)...)
-			locDirectives = append(locDirectives, getSubFilterDirectives(e)...)
-		}
-		locDirectives = append(locDirectives, newDirective(proxyPassDirective, []string{e.GetProxyPassValue()}, nil, nil))
-		directives = append(directives, newDirective(locationDirective, []string{e.GetLocationValue()}, []string{cmt}, newBlock(locDirectives)))
+		directives = append(directives, newDirective(locationDirective, []string{e.GetLocationValue()}, []string{cmt}, newBlock(getLocationDirectives(e, authConf, respConf))))
 	}
 	return directives, nil
 }
 
+// getHttpDirectives returns the limit zones and subdomain server blocks that must be included in the http context.
+func getHttpDirectives(endpoints map[string]endpoint, authConf authConfig, respConf responseConfig, listen, resolver string) ([]config.IDirective, error) {
+	directives := getZoneDirectives(endpoints)
+	srvDirectives, err := getServerDirectives(endpoints, authConf, respConf, listen, resolver)
+	if err != nil {
+		return nil, err
+	}
+	return append(directives, srvDirectives...), nil
+}
+
+func getLocationDirectives(e endpoint, authConf authConfig, respConf responseConfig) []config.IDirective {
+	var directives []config.IDirective
+	directives = append(directives, getAuthDirectives(e, authConf)...)
+	switch e.Type {
+	case lib_model.RedirectEndpoint:
+		return append(directives, getRedirectDirectives(e)...)
+	case lib_model.StaticEndpoint:
+		return append(directives, getStaticDirectives(e, respConf.staticPath)...)
+	}
+	if e.Maintenance != nil {
+		return append(directives, getMaintenanceDirectives(e, respConf.maintenancePage)...)
+	}
+	directives = append(directives, newDirective(setDirective, []string{e.GetSetValue()}, nil, nil))
+	if e.Type != lib_model.DefaultGuiEndpoint {
+		directives = append(directives, newDirective(rewriteDirective, []string{e.GetRewriteValue()}, nil, nil))
+		directives = append(directives, getProxyDirectives(e)...)
+		directives = append(directives, getSubFilterDirectives(e)...)
+	}
+	return append(directives, newDirective(proxyPassDirective, []string{e.GetProxyPassValue()}, nil, nil))
+}
+
 func getProxyDirectives(e endpoint) []config.IDirective {
 	var directives []config.IDirective
 	headers := make(map[string]string)
@@ -299,18 +689,39 @@ func getProxyDirectives(e endpoint) []config.IDirective {
 		headers["Connection"] = "$connection_upgrade"
 	}
 	if e.ProxyConf.ReadTimeout > 0 {
-		directives = append(directives, newDirective(proxyReadTimeoutDirective, []string{e.ProxyConf.ReadTimeout.String()}, nil, nil))
+		directives = append(directives, newDirective(proxyReadTimeoutDirective, []string{formatDuration(e.ProxyConf.ReadTimeout)}, nil, nil))
 	}
-	for key, val := range headers {
-		directives = append(directives, newDirective(proxySetHeaderDirective, []string{key, val}, nil, nil))
+	if e.ProxyConf.ConnectTimeout > 0 {
+		directives = append(directives, newDirective(proxyConnectTimeoutDirective, []string{formatDuration(e.ProxyConf.ConnectTimeout)}, nil, nil))
 	}
+	if e.ProxyConf.SendTimeout > 0 {
+		directives = append(directives, newDirective(proxySendTimeoutDirective, []string{formatDuration(e.ProxyConf.SendTimeout)}, nil, nil))
+	}
+	if e.ProxyConf.Buffering != nil {
+		directives = append(directives, newDirective(proxyBufferingDirective, []string{formatSwitch(*e.ProxyConf.Buffering)}, nil, nil))
+	}
+	if e.ProxyConf.RequestBuffering != nil {
+		directives = append(directives, newDirective(proxyReqBufferingDirective, []string{formatSwitch(*e.ProxyConf.RequestBuffering)}, nil, nil))
+	}
+	for _, key := range sortedKeys(headers) {
+		directives = append(directives, newDirective(proxySetHeaderDirective, []string{key, headers[key]}, nil, nil))
+	}
+	for _, name := range e.ProxyConf.HideHeaders {
+		directives = append(directives, newDirective(proxyHideHeaderDirective, []string{name}, nil, nil))
+	}
+	for _, key := range sortedKeys(e.ProxyConf.ResponseHeaders) {
+		val := strings.Replace(e.ProxyConf.ResponseHeaders[key], locPlaceholder, e.GetLocationValue(), -1)
+		directives = append(directives, newDirective(addHeaderDirective, []string{key, "\"" + val + "\"", "always"}, nil, nil))
+	}
+	directives = append(directives, getLimitDirectives(e)...)
 	return directives
 }
 
 func getSubFilterDirectives(e endpoint) []config.IDirective {
 	var directives []config.IDirective
 	if len(e.StringSub.Filters) > 0 {
-		for orgStr, newStr := range e.StringSub.Filters {
+		for _, orgStr := range sortedKeys(e.StringSub.Filters) {
+			newStr := e.StringSub.Filters[orgStr]
 			directives = append(directives, newDirective(subFilterDirective, []string{"'" + orgStr + "'", "'" + strings.Replace(newStr, locPlaceholder, e.GetLocationValue(), -1) + "'"}, nil, nil))
 		}
 		subFilterTypes := []string{"*"}
@@ -330,7 +741,7 @@ func getSubFilterDirectives(e endpoint) []config.IDirective {
 func getEndpoints(directives []config.IDirective, templates map[int]string) (map[string]endpoint, error) {
 	endpoints := make(map[string]endpoint)
 	for _, directive := range directives {
-		if directive.GetName() == locationDirective {
+		if directive.GetName() == locationDirective || directive.GetName() == serverDirective {
 			comment := directive.GetComment()
 			if len(comment) > 0 {
 				e, err := getEndpoint(comment[0], templates)
@@ -394,6 +805,14 @@ func copy(src, dst string) error {
 	return err
 }
 
+func parseConfig(path string) (*config.Config, error) {
+	p, err := parser.NewParser(path)
+	if err != nil {
+		return nil, err
+	}
+	return p.Parse()
+}
+
 func writeConfig(directives []config.IDirective, path string) error {
 	err := copy(path, path+".bk")
 	if err != nil {
@@ -404,15 +823,18 @@ func writeConfig(directives []config.IDirective, path string) error {
 		FilePath: path,
 	}, dumper.IndentedStyle, false)
 	if err != nil {
-		e := copy(path+".bk", path)
-		if e != nil {
-			util.Logger.Error(e)
-		}
+		restoreConfig(path)
 		return err
 	}
 	return nil
 }
 
+func restoreConfig(path string) {
+	if err := copy(path+".bk", path); err != nil {
+		util.Logger.Error(err)
+	}
+}
+
 func filterEndpoints(endpoints map[string]endpoint, filter lib_model.EndpointFilter) map[string]endpoint {
 	filtered := make(map[string]endpoint)
 	var ids map[string]struct{}
@@ -471,6 +893,109 @@ func checkExtPath(p string) error {
 	return nil
 }
 
+// checkConflicts returns an error if the endpoint would replace an endpoint of a different reference or type or if its
+// location overlaps with the location of an endpoint of a different reference. Default gui endpoints are excluded.
+func checkConflicts(ept endpoint, endpoints map[string]endpoint) error {
+	if ept.Type == lib_model.DefaultGuiEndpoint {
+		return nil
+	}
+	for _, id := range sortedKeys(endpoints) {
+		e := endpoints[id]
+		if id == ept.ID && e.Type != ept.Type {
+			return lib_model.NewInvalidInputError(fmt.Errorf("endpoint '%s' (ref '%s') -> '%s' conflicts with endpoint '%s' (ref '%s') of a different type", ept.ID, ept.Ref, ept.GetLocationValue(), id, e.Ref))
+		}
+		if e.Type == lib_model.DefaultGuiEndpoint || e.Ref == ept.Ref || isSubdomain(e) != isSubdomain(ept) {
+			continue
+		}
+		var conflict bool
+		if isSubdomain(ept) {
+			conflict = ept.GetLocationValue() == e.GetLocationValue()
+		} else {
+			conflict = isPathPrefix(ept.GetLocationValue(), e.GetLocationValue()) || isPathPrefix(e.GetLocationValue(), ept.GetLocationValue())
+		}
+		if id == ept.ID || conflict {
+			return lib_model.NewInvalidInputError(fmt.Errorf("endpoint '%s' (ref '%s') -> '%s' conflicts with endpoint '%s' (ref '%s') -> '%s'", ept.ID, ept.Ref, ept.GetLocationValue(), id, e.Ref, e.GetLocationValue()))
+		}
+	}
+	return nil
+}
+
+// isPathPrefix reports whether prefix equals p or is a prefix of p ending at a path segment boundary.
+func isPathPrefix(p, prefix string) bool {
+	if !strings.HasPrefix(p, prefix) {
+		return false
+	}
+	return len(p) == len(prefix) || strings.HasSuffix(prefix, "/") || p[len(prefix)] == '/'
+}
+
+func checkProxyConf(proxyConf lib_model.ProxyConfig) error {
+	if proxyConf.ReadTimeout < 0 || proxyConf.ConnectTimeout < 0 || proxyConf.SendTimeout < 0 {
+		return lib_model.NewInvalidInputError(errors.New("invalid timeout"))
+	}
+	for name, val := range proxyConf.Headers {
+		if err := checkHeader(name, val); err != nil {
+			return err
+		}
+	}
+	for name, val := range proxyConf.ResponseHeaders {
+		if err := checkHeader(name, val); err != nil {
+			return err
+		}
+	}
+	for _, name := range proxyConf.HideHeaders {
+		if !headerNameRegex.MatchString(name) {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid header name '%s'", name))
+		}
+	}
+	return nil
+}
+
+func checkHeader(name, val string) error {
+	if !headerNameRegex.MatchString(name) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid header name '%s'", name))
+	}
+	if strings.ContainsAny(val, "\"\\\r\n") {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid value for header '%s'", name))
+	}
+	return nil
+}
+
+func checkHealthCheck(hc *lib_model.HealthCheck) error {
+	if hc == nil {
+		return nil
+	}
+	if err := checkIntPath(hc.Path); err != nil {
+		return err
+	}
+	if hc.Interval < 0 {
+		return lib_model.NewInvalidInputError(errors.New("invalid health check interval"))
+	}
+	if hc.ExpectedStatus != 0 && (hc.ExpectedStatus < 100 || hc.ExpectedStatus > 599) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid health check status '%d'", hc.ExpectedStatus))
+	}
+	return nil
+}
+
+func formatDuration(d time.Duration) string {
+	return strconv.FormatInt(d.Milliseconds(), 10) + "ms"
+}
+
+func formatSwitch(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func filterEmpty(f lib_model.EndpointFilter) bool {
 	return !(len(f.IDs) > 0 || f.Type > 0 || f.Ref != "" || len(f.Labels) > 0)
 }
@@ -0,0 +1,186 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"context"
+	"errors"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"strings"
+	"testing"
+	"time"
+)
+
+type ctrHandlerMock struct {
+	err error
+}
+
+func (m *ctrHandlerMock) ExecCmd(_ context.Context, _ []string, _ bool, _ map[string]string, _ string) error {
+	return m.err
+}
+
+func TestReload(t *testing.T) {
+	execErr := lib_model.NewInternalError(&util.ExecError{Cmd: []string{"nginx", "-t"}, Output: "nginx: [emerg] unknown directive \"test\"\n"})
+	h := &Handler{ctrHdl: &ctrHandlerMock{err: execErr}}
+	err := h.reload(context.Background())
+	var iie *lib_model.InvalidInputError
+	if !errors.As(err, &iie) {
+		t.Errorf("expected invalid input error, got %T", err)
+	}
+	if err == nil || !strings.Contains(err.Error(), "unknown directive") {
+		t.Errorf("expected exec output in error, got '%v'", err)
+	}
+	h.ctrHdl = &ctrHandlerMock{err: lib_model.NewInternalError(errors.New("connection refused"))}
+	err = h.reload(context.Background())
+	var ie *lib_model.InternalError
+	if !errors.As(err, &ie) || errors.As(err, &iie) {
+		t.Errorf("expected internal error, got %T", err)
+	}
+}
+
+func TestCheckConflicts(t *testing.T) {
+	templates := testTemplates(t)
+	existing := newEndpoint(lib_model.Endpoint{
+		Type:         lib_model.AliasEndpoint,
+		EndpointBase: lib_model.EndpointBase{Ref: "a", Host: "host", ExtPath: "foo"},
+	}, templates)
+	redirect := newEndpoint(lib_model.Endpoint{
+		Type:         lib_model.RedirectEndpoint,
+		EndpointBase: lib_model.EndpointBase{Ref: "a", ExtPath: "old"},
+	}, templates)
+	endpoints := map[string]endpoint{existing.ID: existing, redirect.ID: redirect}
+	tests := []struct {
+		name     string
+		eType    lib_model.EndpointType
+		ref      string
+		extPath  string
+		conflict bool
+	}{
+		{name: "same location", ref: "b", extPath: "foo", conflict: true},
+		{name: "sub path", ref: "b", extPath: "foo/bar", conflict: true},
+		{name: "parent path", ref: "b", extPath: "", conflict: true},
+		{name: "common prefix", ref: "b", extPath: "foobar"},
+		{name: "shorter common prefix", ref: "b", extPath: "fo"},
+		{name: "same reference", ref: "a", extPath: "foo/bar"},
+		{name: "same location different type", eType: lib_model.StaticEndpoint, ref: "a", extPath: "old", conflict: true},
+		{name: "same location different reference", eType: lib_model.RedirectEndpoint, ref: "b", extPath: "old", conflict: true},
+		{name: "same location same reference and type", eType: lib_model.RedirectEndpoint, ref: "a", extPath: "old"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eType := tc.eType
+			if eType == 0 {
+				eType = lib_model.AliasEndpoint
+			}
+			e := newEndpoint(lib_model.Endpoint{
+				Type:         eType,
+				EndpointBase: lib_model.EndpointBase{Ref: tc.ref, Host: "host", ExtPath: tc.extPath},
+			}, templates)
+			err := checkConflicts(e, endpoints)
+			if tc.conflict && err == nil {
+				t.Errorf("expected conflict for '%s'", e.GetLocationValue())
+			}
+			if !tc.conflict && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestIsPathPrefix(t *testing.T) {
+	tests := []struct {
+		p      string
+		prefix string
+		want   bool
+	}{
+		{"/foo", "/foo", true},
+		{"/foo/bar", "/foo", true},
+		{"/foo/bar", "/foo/", true},
+		{"/foobar", "/foo", false},
+		{"/foo", "/foo/bar", false},
+		{"/", "/", true},
+	}
+	for _, tc := range tests {
+		if got := isPathPrefix(tc.p, tc.prefix); got != tc.want {
+			t.Errorf("isPathPrefix(%q, %q) = %v, want %v", tc.p, tc.prefix, got, tc.want)
+		}
+	}
+}
+
+func TestGetProxyDirectives(t *testing.T) {
+	e := newEndpoint(lib_model.Endpoint{
+		Type: lib_model.StandardEndpoint,
+		EndpointBase: lib_model.EndpointBase{
+			Ref:     "a",
+			Host:    "host",
+			ExtPath: "foo",
+			ProxyConf: lib_model.ProxyConfig{
+				ReadTimeout:    time.Minute,
+				ConnectTimeout: 5 * time.Second,
+				SendTimeout:    1500 * time.Millisecond,
+			},
+		},
+	}, testTemplates(t))
+	want := map[string]string{
+		proxyReadTimeoutDirective:    "60000ms",
+		proxyConnectTimeoutDirective: "5000ms",
+		proxySendTimeoutDirective:    "1500ms",
+	}
+	for _, directive := range getProxyDirectives(e) {
+		val, ok := want[directive.GetName()]
+		if !ok {
+			continue
+		}
+		if params := directive.GetParameters(); len(params) != 1 || params[0] != val {
+			t.Errorf("%s: expected '%s', got %v", directive.GetName(), val, params)
+		}
+		delete(want, directive.GetName())
+	}
+	for name := range want {
+		t.Errorf("missing directive %s", name)
+	}
+}
+
+func TestCheckProxyConf(t *testing.T) {
+	tests := []struct {
+		name      string
+		proxyConf lib_model.ProxyConfig
+		invalid   bool
+	}{
+		{name: "valid headers", proxyConf: lib_model.ProxyConfig{Headers: map[string]string{"X-Test": "$host"}, ResponseHeaders: map[string]string{"X-Test": "test value"}}},
+		{name: "invalid header name", proxyConf: lib_model.ProxyConfig{Headers: map[string]string{"X Test": "test"}}, invalid: true},
+		{name: "quote in header value", proxyConf: lib_model.ProxyConfig{Headers: map[string]string{"X-Test": "te\"st"}}, invalid: true},
+		{name: "newline in header value", proxyConf: lib_model.ProxyConfig{Headers: map[string]string{"X-Test": "test\nproxy_pass x"}}, invalid: true},
+		{name: "backslash in response header value", proxyConf: lib_model.ProxyConfig{ResponseHeaders: map[string]string{"X-Test": "te\\st"}}, invalid: true},
+		{name: "invalid response header name", proxyConf: lib_model.ProxyConfig{ResponseHeaders: map[string]string{"X;Test": "test"}}, invalid: true},
+		{name: "invalid hidden header", proxyConf: lib_model.ProxyConfig{HideHeaders: []string{"X Test"}}, invalid: true},
+		{name: "negative timeout", proxyConf: lib_model.ProxyConfig{ReadTimeout: -time.Second}, invalid: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkProxyConf(tc.proxyConf)
+			var iie *lib_model.InvalidInputError
+			if tc.invalid && !errors.As(err, &iie) {
+				t.Errorf("expected invalid input error, got '%v'", err)
+			}
+			if !tc.invalid && err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"os"
+	"path"
+	"strconv"
+	"time"
+)
+
+const historyIndexFile = "history.json"
+
+func (h *Handler) ListHistory(_ context.Context) ([]lib_model.EndpointConfigVersion, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	history := make([]lib_model.EndpointConfigVersion, 0, len(h.history))
+	for i := len(h.history) - 1; i >= 0; i-- {
+		history = append(history, h.history[i])
+	}
+	return history, nil
+}
+
+// Restore replaces the current endpoints with the endpoints of the given version. Endpoints are migrated to the current
+// templates. Stream endpoints are not versioned and remain unchanged.
+func (h *Handler) Restore(ctx context.Context, version int) error {
+	h.m.Lock()
+	defer h.m.Unlock()
+	var ok bool
+	for _, item := range h.history {
+		if item.Version == version {
+			ok = true
+			break
+		}
+	}
+	if !ok {
+		return lib_model.NewNotFoundError(fmt.Errorf("version '%d' not found", version))
+	}
+	conf, err := parseConfig(h.getHistoryFilePath(version))
+	if err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	endpoints, err := getEndpoints(conf.GetDirectives(), h.templates)
+	if err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if _, err = os.Stat(h.getHistoryHttpFilePath(version)); err == nil {
+		httpConf, err := parseConfig(h.getHistoryHttpFilePath(version))
+		if err != nil {
+			return lib_model.NewInternalError(err)
+		}
+		httpEndpoints, err := getEndpoints(httpConf.GetDirectives(), h.templates)
+		if err != nil {
+			return lib_model.NewInternalError(err)
+		}
+		for id, e := range httpEndpoints {
+			endpoints[id] = e
+		}
+	}
+	endpoints, err = migrateEndpoints(endpoints, h.templates)
+	if err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	return h.update(ctx, endpoints)
+}
+
+func (h *Handler) initHistory() error {
+	if h.historySize < 1 {
+		return nil
+	}
+	if err := os.MkdirAll(h.historyPath, 0775); err != nil {
+		return err
+	}
+	b, err := os.ReadFile(path.Join(h.historyPath, historyIndexFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return json.Unmarshal(b, &h.history)
+}
+
+// addHistoryItem stores the current config as a new version and removes the oldest versions exceeding the history size.
+func (h *Handler) addHistoryItem(ctx context.Context, endpoints map[string]endpoint) error {
+	if h.historySize < 1 {
+		return nil
+	}
+	item := lib_model.EndpointConfigVersion{
+		Version:   1,
+		Timestamp: time.Now().UTC(),
+		JobID:     util.GetJobID(ctx),
+	}
+	if len(h.history) > 0 {
+		item.Version = h.history[len(h.history)-1].Version + 1
+	}
+	item.Added, item.Replaced, item.Removed = h.getChangedIDs(endpoints)
+	if err := copy(h.confPath, h.getHistoryFilePath(item.Version)); err != nil {
+		return err
+	}
+	if h.httpConfPath != "" {
+		if err := copy(h.httpConfPath, h.getHistoryHttpFilePath(item.Version)); err != nil {
+			return err
+		}
+	}
+	history := append(h.history, item)
+	var expired []lib_model.EndpointConfigVersion
+	if len(history) > h.historySize {
+		expired = history[:len(history)-h.historySize]
+		history = history[len(history)-h.historySize:]
+	}
+	if err := writeHistoryIndex(path.Join(h.historyPath, historyIndexFile), history); err != nil {
+		return err
+	}
+	h.history = history
+	for _, e := range expired {
+		for _, p := range []string{h.getHistoryFilePath(e.Version), h.getHistoryHttpFilePath(e.Version)} {
+			if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+				util.Logger.Error(err)
+			}
+		}
+	}
+	return nil
+}
+
+func (h *Handler) getHistoryFilePath(version int) string {
+	return path.Join(h.historyPath, strconv.FormatInt(int64(version), 10)+".conf")
+}
+
+func (h *Handler) getHistoryHttpFilePath(version int) string {
+	return path.Join(h.historyPath, strconv.FormatInt(int64(version), 10)+".http.conf")
+}
+
+func writeHistoryIndex(p string, history []lib_model.EndpointConfigVersion) error {
+	file, err := os.Create(p + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(p + ".tmp")
+	defer file.Close()
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(history); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(p+".tmp", p)
+}
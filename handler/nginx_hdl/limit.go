@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"regexp"
+	"strconv"
+)
+
+const (
+	zoneSize       = "1m"
+	zoneKey        = "$binary_remote_addr"
+	reqZonePrefix  = "req_"
+	connZonePrefix = "conn_"
+)
+
+var sizeRegex = regexp.MustCompile(`^[0-9]+[kKmMgG]?$`)
+
+func checkLimits(proxyConf lib_model.ProxyConfig, httpConfPath string) error {
+	if proxyConf.ClientMaxBodySize != "" && !sizeRegex.MatchString(proxyConf.ClientMaxBodySize) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid client max body size '%s'", proxyConf.ClientMaxBodySize))
+	}
+	if proxyConf.ReqRate < 0 || proxyConf.ReqBurst < 0 || proxyConf.ConnLimit < 0 {
+		return lib_model.NewInvalidInputError(errors.New("invalid limits"))
+	}
+	if proxyConf.ReqBurst > 0 && proxyConf.ReqRate == 0 {
+		return lib_model.NewInvalidInputError(errors.New("request burst requires request rate"))
+	}
+	if (proxyConf.ReqRate > 0 || proxyConf.ConnLimit > 0) && httpConfPath == "" {
+		return lib_model.NewInvalidInputError(errors.New("limits not supported, http config path not set"))
+	}
+	return nil
+}
+
+func getLimitDirectives(e endpoint) []config.IDirective {
+	var directives []config.IDirective
+	if e.ProxyConf.ClientMaxBodySize != "" {
+		directives = append(directives, newDirective(clientMaxBodySizeDirective, []string{e.ProxyConf.ClientMaxBodySize}, nil, nil))
+	}
+	if e.ProxyConf.ReqRate > 0 {
+		params := []string{"zone=" + reqZonePrefix + e.ID}
+		if e.ProxyConf.ReqBurst > 0 {
+			params = append(params, "burst="+strconv.FormatInt(int64(e.ProxyConf.ReqBurst), 10))
+		}
+		directives = append(directives, newDirective(limitReqDirective, params, nil, nil))
+	}
+	if e.ProxyConf.ConnLimit > 0 {
+		directives = append(directives, newDirective(limitConnDirective, []string{connZonePrefix + e.ID, strconv.FormatInt(int64(e.ProxyConf.ConnLimit), 10)}, nil, nil))
+	}
+	return directives
+}
+
+// getZoneDirectives returns the shared memory zones required by the limits of the given endpoints. The zones must be
+// included in the http context.
+func getZoneDirectives(endpoints map[string]endpoint) []config.IDirective {
+	var directives []config.IDirective
+	for _, id := range sortedKeys(endpoints) {
+		e := endpoints[id]
+		if e.Type == lib_model.DefaultGuiEndpoint {
+			continue
+		}
+		if e.ProxyConf.ReqRate > 0 {
+			directives = append(directives, newDirective(limitReqZoneDirective, []string{zoneKey, "zone=" + reqZonePrefix + e.ID + ":" + zoneSize, "rate=" + strconv.FormatInt(int64(e.ProxyConf.ReqRate), 10) + "r/s"}, nil, nil))
+		}
+		if e.ProxyConf.ConnLimit > 0 {
+			directives = append(directives, newDirective(limitConnZoneDirective, []string{zoneKey, "zone=" + connZonePrefix + e.ID + ":" + zoneSize}, nil, nil))
+		}
+	}
+	return directives
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"math"
+	"strconv"
+)
+
+const (
+	maintenanceStatus      = 503
+	maintenanceContentType = "text/html"
+	retryAfterHeader       = "Retry-After"
+)
+
+func (h *Handler) SetMaintenance(ctx context.Context, id string, maintenance lib_model.EndpointMaintenance) error {
+	if err := checkMaintenance(maintenance); err != nil {
+		return err
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	e, ok := h.endpoints[id]
+	if !ok {
+		return lib_model.NewNotFoundError(fmt.Errorf("endpoint '%s' not found", id))
+	}
+	if isResponse(e) {
+		return lib_model.NewInvalidInputError(errors.New("maintenance mode not supported for redirect and static response endpoints"))
+	}
+	endpointsCopy := make(map[string]endpoint)
+	for id2, e2 := range h.endpoints {
+		endpointsCopy[id2] = e2
+	}
+	h.setMaintenance(endpointsCopy, id, maintenance)
+	return h.update(ctx, endpointsCopy)
+}
+
+func (h *Handler) SetMaintenanceAll(ctx context.Context, filter lib_model.EndpointFilter, maintenance lib_model.EndpointMaintenance) error {
+	if err := checkMaintenance(maintenance); err != nil {
+		return err
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	filtered := filterEndpoints(h.endpoints, filter)
+	if len(filtered) == 0 {
+		return nil
+	}
+	endpointsCopy := make(map[string]endpoint)
+	for id, e := range h.endpoints {
+		endpointsCopy[id] = e
+	}
+	for id, e := range filtered {
+		if isResponse(e) {
+			continue
+		}
+		h.setMaintenance(endpointsCopy, id, maintenance)
+	}
+	return h.update(ctx, endpointsCopy)
+}
+
+// setMaintenance enables or disables maintenance mode for an endpoint and its aliases.
+func (h *Handler) setMaintenance(endpoints map[string]endpoint, id string, maintenance lib_model.EndpointMaintenance) {
+	for _, eID := range append(h.getAliases(id), id) {
+		e, ok := endpoints[eID]
+		if !ok {
+			continue
+		}
+		if maintenance.Enabled {
+			m := maintenance
+			e.Maintenance = &m
+		} else {
+			e.Maintenance = nil
+		}
+		endpoints[eID] = e
+	}
+}
+
+func checkMaintenance(maintenance lib_model.EndpointMaintenance) error {
+	if maintenance.RetryAfter < 0 {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid retry after '%s'", maintenance.RetryAfter))
+	}
+	return nil
+}
+
+// getMaintenanceDirectives returns the directives that replace the proxy directives of an endpoint in maintenance mode.
+// Requests are answered with 503 and the maintenance page if configured.
+func getMaintenanceDirectives(e endpoint, maintenancePage string) []config.IDirective {
+	var directives []config.IDirective
+	if e.Maintenance.RetryAfter > 0 {
+		retryAfter := strconv.FormatInt(int64(math.Ceil(e.Maintenance.RetryAfter.Seconds())), 10)
+		directives = append(directives, newDirective(addHeaderDirective, []string{retryAfterHeader, retryAfter, "always"}, nil, nil))
+	}
+	if maintenancePage == "" {
+		return append(directives, newDirective(returnDirective, []string{strconv.FormatInt(maintenanceStatus, 10)}, nil, nil))
+	}
+	location := e.GetLocationValue()
+	if isSubdomain(e) {
+		location = subdomainLocation
+	}
+	return append(directives, getFileDirectives(location, maintenanceStatus, maintenanceContentType, maintenancePage)...)
+}
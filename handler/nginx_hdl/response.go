@@ -0,0 +1,201 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"mime"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	maxStaticBodySize  = 4096
+	defaultContentType = "text/plain"
+	staticFileLocation = "/.static"
+	invalidValueChars  = " \t\r\n\"\\;{}"
+)
+
+type responseConfig struct {
+	staticPath      string
+	maintenancePage string
+}
+
+var redirectStatusCodes = map[int]struct{}{
+	lib_model.RedirectMovedPermanently:  {},
+	lib_model.RedirectFound:             {},
+	lib_model.RedirectTemporaryRedirect: {},
+}
+
+// getResponseType returns the endpoint type for endpoints that respond directly instead of proxying requests.
+func getResponseType(eBase lib_model.EndpointBase) (lib_model.EndpointType, bool) {
+	if eBase.Redirect != nil {
+		return lib_model.RedirectEndpoint, true
+	}
+	if eBase.Static != nil {
+		return lib_model.StaticEndpoint, true
+	}
+	return 0, false
+}
+
+func isResponse(e endpoint) bool {
+	return e.Type == lib_model.RedirectEndpoint || e.Type == lib_model.StaticEndpoint
+}
+
+// checkResponse validates the redirect and static response configs and ensures they are not combined with options
+// that require an upstream.
+func checkResponse(eBase lib_model.EndpointBase, staticPath string) error {
+	if eBase.Redirect == nil && eBase.Static == nil {
+		return nil
+	}
+	if eBase.Redirect != nil && eBase.Static != nil {
+		return lib_model.NewInvalidInputError(errors.New("redirect and static response are mutually exclusive"))
+	}
+	if eBase.Subdomain || eBase.Upstream != nil || eBase.HealthCheck != nil {
+		return lib_model.NewInvalidInputError(errors.New("subdomain, upstream and health check not supported for redirect and static response endpoints"))
+	}
+	if eBase.ExtPath == "" {
+		return lib_model.NewInvalidInputError(errors.New("redirect and static response endpoints require a path"))
+	}
+	if eBase.Redirect != nil {
+		return checkRedirect(eBase.Redirect)
+	}
+	return checkStatic(eBase.Static, staticPath)
+}
+
+func checkRedirect(r *lib_model.Redirect) error {
+	if r.Status != 0 {
+		if _, ok := redirectStatusCodes[r.Status]; !ok {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid redirect status '%d'", r.Status))
+		}
+	}
+	if r.Target == "" || strings.ContainsAny(r.Target, invalidValueChars) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid redirect target '%s'", r.Target))
+	}
+	if !strings.HasPrefix(r.Target, "/") {
+		u, err := url.Parse(r.Target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid redirect target '%s'", r.Target))
+		}
+	}
+	return nil
+}
+
+func checkStatic(s *lib_model.StaticResponse, staticPath string) error {
+	if s.Status != 0 && (s.Status < 200 || s.Status > 599 || (s.Status >= 300 && s.Status < 400)) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid static response status '%d'", s.Status))
+	}
+	if s.ContentType != "" {
+		if _, _, err := mime.ParseMediaType(s.ContentType); err != nil || strings.ContainsAny(s.ContentType, "\"\\") {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid content type '%s'", s.ContentType))
+		}
+	}
+	if s.File == "" {
+		if len(s.Body) > maxStaticBodySize {
+			return lib_model.NewInvalidInputError(fmt.Errorf("static response body exceeds %d bytes", maxStaticBodySize))
+		}
+		if strings.Contains(s.Body, "$") {
+			return lib_model.NewInvalidInputError(errors.New("'$' not allowed in static response body, use a file instead"))
+		}
+		return nil
+	}
+	if s.Body != "" {
+		return lib_model.NewInvalidInputError(errors.New("static response body and file are mutually exclusive"))
+	}
+	if staticPath == "" {
+		return lib_model.NewInvalidInputError(errors.New("static response files not supported"))
+	}
+	if !filepath.IsLocal(s.File) || strings.ContainsAny(s.File, invalidValueChars) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid static response file '%s'", s.File))
+	}
+	info, err := os.Stat(path.Join(staticPath, s.File))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return lib_model.NewInvalidInputError(fmt.Errorf("static response file '%s' not found", s.File))
+		}
+		return lib_model.NewInternalError(err)
+	}
+	if !info.Mode().IsRegular() {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid static response file '%s'", s.File))
+	}
+	return nil
+}
+
+func getRedirectDirectives(e endpoint) []config.IDirective {
+	status := lib_model.RedirectFound
+	if e.Redirect.Status > 0 {
+		status = e.Redirect.Status
+	}
+	return []config.IDirective{
+		newDirective(returnDirective, []string{strconv.FormatInt(int64(status), 10), "\"" + e.Redirect.Target + "\""}, nil, nil),
+	}
+}
+
+// getStaticDirectives returns the directives for a static response. Bodies are returned directly, files are served
+// via getFileDirectives.
+func getStaticDirectives(e endpoint, staticPath string) []config.IDirective {
+	status := 200
+	if e.Static.Status > 0 {
+		status = e.Static.Status
+	}
+	contentType := defaultContentType
+	if e.Static.ContentType != "" {
+		contentType = e.Static.ContentType
+	}
+	if e.Static.File == "" {
+		return []config.IDirective{
+			newDirective(defaultTypeDirective, []string{"\"" + contentType + "\""}, nil, nil),
+			newDirective(returnDirective, []string{strconv.FormatInt(int64(status), 10), quoteString(e.Static.Body)}, nil, nil),
+		}
+	}
+	return getFileDirectives(e.GetLocationValue(), status, contentType, path.Join(staticPath, e.Static.File))
+}
+
+// getFileDirectives returns directives that serve a file from an internal nested location via an internal redirect in
+// order to support status codes other than 200.
+func getFileDirectives(location string, status int, contentType, filePath string) []config.IDirective {
+	statusStr := strconv.FormatInt(int64(status), 10)
+	fileLocation := strings.TrimSuffix(location, "/") + staticFileLocation
+	var directives []config.IDirective
+	if status == 200 {
+		directives = append(directives, newDirective(rewriteDirective, []string{"^", fileLocation, "last"}, nil, nil))
+	} else {
+		directives = append(directives, newDirective(errorPageDirective, []string{statusStr, fileLocation}, nil, nil))
+		directives = append(directives, newDirective(returnDirective, []string{statusStr}, nil, nil))
+	}
+	fileDirectives := []config.IDirective{
+		newDirective(internalDirective, nil, nil, nil),
+		newDirective(defaultTypeDirective, []string{"\"" + contentType + "\""}, nil, nil),
+		newDirective(aliasDirective, []string{filePath}, nil, nil),
+	}
+	return append(directives, newDirective(locationDirective, []string{"=", fileLocation}, nil, newBlock(fileDirectives)))
+}
+
+func quoteString(s string) string {
+	s = strings.ReplaceAll(s, "\\", "\\\\")
+	s = strings.ReplaceAll(s, "\"", "\\\"")
+	s = strings.ReplaceAll(s, "\r", "\\r")
+	s = strings.ReplaceAll(s, "\n", "\\n")
+	return "\"" + s + "\""
+}
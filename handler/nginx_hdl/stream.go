@@ -0,0 +1,306 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"github.com/tufanbarisyildirim/gonginx/dumper"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type streamConfig struct {
+	confPath string
+	portMin  int
+	portMax  int
+}
+
+func (h *Handler) ListStreams(ctx context.Context, filter lib_model.StreamEndpointFilter) (map[string]lib_model.StreamEndpoint, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	streams := make(map[string]lib_model.StreamEndpoint)
+	for id, s := range filterStreams(h.streams, filter) {
+		if ctx.Err() != nil {
+			return nil, lib_model.NewInternalError(ctx.Err())
+		}
+		streams[id] = s
+	}
+	return streams, nil
+}
+
+func (h *Handler) GetStream(_ context.Context, id string) (lib_model.StreamEndpoint, error) {
+	h.m.RLock()
+	defer h.m.RUnlock()
+	s, ok := h.streams[id]
+	if !ok {
+		return lib_model.StreamEndpoint{}, lib_model.NewNotFoundError(errors.New("stream endpoint not found"))
+	}
+	return s, nil
+}
+
+// SetStream adds a stream endpoint and returns its ID. If no external port is provided, the port of an existing endpoint
+// with the same target is reused or a free port is allocated. An existing endpoint using the same port with a different
+// reference or target is only replaced if force is set.
+func (h *Handler) SetStream(ctx context.Context, sBase lib_model.StreamEndpointBase, force bool) (string, error) {
+	if h.streamConf.confPath == "" {
+		return "", lib_model.NewInvalidInputError(errors.New("stream endpoints not supported"))
+	}
+	if sBase.Protocol == "" {
+		sBase.Protocol = lib_model.StreamProtocolTCP
+	}
+	if err := checkStream(sBase, h.streamConf); err != nil {
+		return "", err
+	}
+	h.m.Lock()
+	defer h.m.Unlock()
+	if sBase.ExtPort == 0 {
+		port, err := allocStreamPort(sBase, h.streams, h.streamConf)
+		if err != nil {
+			return "", err
+		}
+		sBase.ExtPort = port
+	}
+	s := lib_model.StreamEndpoint{
+		ID:                 genStreamID(sBase.Protocol, sBase.ExtPort),
+		StreamEndpointBase: sBase,
+	}
+	if s2, ok := h.streams[s.ID]; ok && (s2.Ref != s.Ref || s2.Host != s.Host || s2.Port != s.Port) {
+		if !force {
+			return "", lib_model.NewInvalidInputError(fmt.Errorf("port '%d/%s' already used by stream endpoint '%s' (ref '%s', target '%s:%d')", s.ExtPort, s.Protocol, s2.ID, s2.Ref, s2.Host, s2.Port))
+		}
+		util.Logger.Warningf("stream endpoint '%s' '%+v' replaced by '%+v'", s2.ID, s2.StreamEndpointBase, s.StreamEndpointBase)
+	}
+	streamsCopy := make(map[string]lib_model.StreamEndpoint)
+	for id, s2 := range h.streams {
+		streamsCopy[id] = s2
+	}
+	streamsCopy[s.ID] = s
+	if err := h.updateStreams(ctx, streamsCopy); err != nil {
+		return "", err
+	}
+	return s.ID, nil
+}
+
+func (h *Handler) RemoveStream(ctx context.Context, id string) error {
+	h.m.Lock()
+	defer h.m.Unlock()
+	if _, ok := h.streams[id]; !ok {
+		return lib_model.NewNotFoundError(errors.New("stream endpoint not found"))
+	}
+	streamsCopy := make(map[string]lib_model.StreamEndpoint)
+	for sID, s := range h.streams {
+		if sID != id {
+			streamsCopy[sID] = s
+		}
+	}
+	return h.updateStreams(ctx, streamsCopy)
+}
+
+func (h *Handler) RemoveAllStreams(ctx context.Context, filter lib_model.StreamEndpointFilter) error {
+	h.m.Lock()
+	defer h.m.Unlock()
+	filtered := filterStreams(h.streams, filter)
+	if len(filtered) == 0 {
+		return nil
+	}
+	streamsCopy := make(map[string]lib_model.StreamEndpoint)
+	for id, s := range h.streams {
+		if _, ok := filtered[id]; !ok {
+			streamsCopy[id] = s
+		}
+	}
+	return h.updateStreams(ctx, streamsCopy)
+}
+
+func (h *Handler) initStreams() error {
+	h.streams = make(map[string]lib_model.StreamEndpoint)
+	if h.streamConf.confPath == "" {
+		return nil
+	}
+	if _, err := os.Stat(h.streamConf.confPath); err != nil {
+		if !os.IsNotExist(err) {
+			return err
+		}
+		if err = os.WriteFile(h.streamConf.confPath, nil, 0644); err != nil {
+			return err
+		}
+	}
+	conf, err := parseConfig(h.streamConf.confPath)
+	if err != nil {
+		return err
+	}
+	h.streams, err = getStreams(conf.GetDirectives())
+	if err != nil {
+		return err
+	}
+	directives, err := getStreamDirectives(h.streams, h.resolver)
+	if err != nil {
+		return err
+	}
+	if dumper.DumpBlock(newBlock(directives), dumper.IndentedStyle) != dumper.DumpConfig(conf, dumper.IndentedStyle) {
+		util.Logger.Warning("stream config does not match stream endpoints, re-rendering streams ...")
+		return writeConfig(directives, h.streamConf.confPath)
+	}
+	return nil
+}
+
+func (h *Handler) updateStreams(ctx context.Context, streams map[string]lib_model.StreamEndpoint) error {
+	directives, err := getStreamDirectives(streams, h.resolver)
+	if err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if ctx.Err() != nil {
+		return lib_model.NewInternalError(ctx.Err())
+	}
+	if err = writeConfig(directives, h.streamConf.confPath); err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if err = h.reload(ctx); err != nil {
+		restoreConfig(h.streamConf.confPath)
+		return err
+	}
+	h.streams = streams
+	return nil
+}
+
+func checkStream(sBase lib_model.StreamEndpointBase, conf streamConfig) error {
+	if sBase.Host == "" || strings.ContainsAny(sBase.Host, " \t\r\n;{}'\"") {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid host '%s'", sBase.Host))
+	}
+	if sBase.Port < 1 || sBase.Port > 65535 {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid port '%d'", sBase.Port))
+	}
+	if sBase.Protocol != lib_model.StreamProtocolTCP && sBase.Protocol != lib_model.StreamProtocolUDP {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid protocol '%s'", sBase.Protocol))
+	}
+	if sBase.ExtPort != 0 && (sBase.ExtPort < conf.portMin || sBase.ExtPort > conf.portMax) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("external port '%d' not in range %d-%d", sBase.ExtPort, conf.portMin, conf.portMax))
+	}
+	return nil
+}
+
+func allocStreamPort(sBase lib_model.StreamEndpointBase, streams map[string]lib_model.StreamEndpoint, conf streamConfig) (int, error) {
+	used := make(map[int]struct{})
+	for _, s := range streams {
+		if s.Protocol != sBase.Protocol {
+			continue
+		}
+		if s.Ref == sBase.Ref && s.Host == sBase.Host && s.Port == sBase.Port {
+			return s.ExtPort, nil
+		}
+		used[s.ExtPort] = struct{}{}
+	}
+	for port := conf.portMin; port <= conf.portMax; port++ {
+		if _, ok := used[port]; !ok {
+			return port, nil
+		}
+	}
+	return 0, lib_model.NewInvalidInputError(fmt.Errorf("no free %s port in range %d-%d", sBase.Protocol, conf.portMin, conf.portMax))
+}
+
+func genStreamID(protocol string, port int) string {
+	return util.GenHash(protocol + ":" + strconv.FormatInt(int64(port), 10))
+}
+
+// getStreamDirectives returns a server block for every stream endpoint. The blocks must be included in the stream
+// context. Targets are resolved at runtime via a variable, so unavailable targets do not prevent nginx from loading the
+// config.
+func getStreamDirectives(streams map[string]lib_model.StreamEndpoint, resolver string) ([]config.IDirective, error) {
+	var directives []config.IDirective
+	for _, id := range sortedKeys(streams) {
+		s := streams[id]
+		b, err := json.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		listenParams := []string{strconv.FormatInt(int64(s.ExtPort), 10)}
+		if s.Protocol == lib_model.StreamProtocolUDP {
+			listenParams = append(listenParams, lib_model.StreamProtocolUDP)
+		}
+		srvDirectives := []config.IDirective{newDirective(listenDirective, listenParams, nil, nil)}
+		if resolver != "" {
+			srvDirectives = append(srvDirectives, newDirective(resolverDirective, []string{resolver, resolverValid}, nil, nil))
+		}
+		srvDirectives = append(srvDirectives,
+			newDirective(setDirective, []string{"$s" + s.ID, s.Host + ":" + strconv.FormatInt(int64(s.Port), 10)}, nil, nil),
+			newDirective(proxyPassDirective, []string{"$s" + s.ID}, nil, nil),
+		)
+		directives = append(directives, newDirective(serverDirective, nil, []string{"#" + base64.StdEncoding.EncodeToString(b)}, newBlock(srvDirectives)))
+	}
+	return directives, nil
+}
+
+func getStreams(directives []config.IDirective) (map[string]lib_model.StreamEndpoint, error) {
+	streams := make(map[string]lib_model.StreamEndpoint)
+	for _, directive := range directives {
+		if directive.GetName() == serverDirective {
+			comment := directive.GetComment()
+			if len(comment) > 0 {
+				s, _ := strings.CutPrefix(comment[0], "#")
+				b, err := base64.StdEncoding.DecodeString(s)
+				if err != nil {
+					return nil, err
+				}
+				var stream lib_model.StreamEndpoint
+				if err = json.Unmarshal(b, &stream); err != nil {
+					return nil, err
+				}
+				streams[stream.ID] = stream
+			}
+		}
+	}
+	return streams, nil
+}
+
+func filterStreams(streams map[string]lib_model.StreamEndpoint, filter lib_model.StreamEndpointFilter) map[string]lib_model.StreamEndpoint {
+	filtered := make(map[string]lib_model.StreamEndpoint)
+	var ids map[string]struct{}
+	if len(filter.IDs) > 0 {
+		ids = make(map[string]struct{})
+		for _, id := range filter.IDs {
+			ids[id] = struct{}{}
+		}
+	}
+	for id, s := range streams {
+		if len(ids) > 0 {
+			if _, ok := ids[id]; !ok {
+				continue
+			}
+		}
+		if filter.Ref != "" && s.Ref != filter.Ref {
+			continue
+		}
+		if filter.Protocol != "" && s.Protocol != filter.Protocol {
+			continue
+		}
+		if len(filter.Labels) > 0 {
+			if !mapInMap(filter.Labels, s.Labels) {
+				continue
+			}
+		}
+		filtered[id] = s
+	}
+	return filtered
+}
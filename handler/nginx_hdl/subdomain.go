@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"regexp"
+	"strings"
+)
+
+const subdomainLocation = "/"
+
+var serverNameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*$`)
+
+func isSubdomain(e endpoint) bool {
+	return e.Type == lib_model.SubdomainEndpoint || e.Type == lib_model.SubdomainAliasEndpoint
+}
+
+func checkServerName(name string) error {
+	if !serverNameRegex.MatchString(name) {
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid server name '%s'", name))
+	}
+	return nil
+}
+
+// getServerDirectives returns a server block for every subdomain endpoint. The location value of subdomain endpoints
+// is used as server name. The blocks must be included in the http context. Server blocks do not inherit the resolver of
+// the main server, so the resolver is set per block if provided.
+func getServerDirectives(endpoints map[string]endpoint, authConf authConfig, respConf responseConfig, listen, resolver string) ([]config.IDirective, error) {
+	var directives []config.IDirective
+	for _, id := range sortedKeys(endpoints) {
+		e := endpoints[id]
+		if !isSubdomain(e) {
+			continue
+		}
+		cmt, err := e.GenComment()
+		if err != nil {
+			return nil, err
+		}
+		var srvDirectives []config.IDirective
+		if listen != "" {
+			srvDirectives = append(srvDirectives, newDirective(listenDirective, strings.Fields(listen), nil, nil))
+		}
+		srvDirectives = append(srvDirectives, newDirective(serverNameDirective, []string{e.GetLocationValue()}, nil, nil))
+		if resolver != "" {
+			srvDirectives = append(srvDirectives, newDirective(resolverDirective, []string{resolver, resolverValid}, nil, nil))
+		}
+		srvDirectives = append(srvDirectives, newDirective(locationDirective, []string{subdomainLocation}, nil, newBlock(getLocationDirectives(e, authConf, respConf))))
+		directives = append(directives, newDirective(serverDirective, nil, []string{cmt}, newBlock(srvDirectives)))
+	}
+	return directives, nil
+}
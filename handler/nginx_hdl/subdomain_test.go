@@ -0,0 +1,146 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"github.com/tufanbarisyildirim/gonginx/dumper"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func testTemplates(t *testing.T) map[int]string {
+	cfg, err := util.NewConfig("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[int]string{
+		StandardLocationTmpl:         cfg.EndpointTemplates.StandardLocation,
+		StandardRewriteTmpl:          cfg.EndpointTemplates.StandardRewrite,
+		StandardProxyPassTmpl:        cfg.EndpointTemplates.StandardProxyPass,
+		DefaultGuiLocationTmpl:       cfg.EndpointTemplates.DefaultGuiLocation,
+		DefaultGuiProxyPassTmpl:      cfg.EndpointTemplates.DefaultGuiProxyPass,
+		AliasLocationTmpl:            cfg.EndpointTemplates.AliasLocation,
+		AliasRewriteTmpl:             cfg.EndpointTemplates.AliasRewrite,
+		AliasProxyPassTmpl:           cfg.EndpointTemplates.AliasProxyPass,
+		SubdomainServerNameTmpl:      cfg.EndpointTemplates.SubdomainServerName,
+		SubdomainRewriteTmpl:         cfg.EndpointTemplates.SubdomainRewrite,
+		SubdomainProxyPassTmpl:       cfg.EndpointTemplates.SubdomainProxyPass,
+		SubdomainAliasServerNameTmpl: cfg.EndpointTemplates.SubdomainAliasServerName,
+		SubdomainAliasRewriteTmpl:    cfg.EndpointTemplates.SubdomainAliasRewrite,
+		SubdomainAliasProxyPassTmpl:  cfg.EndpointTemplates.SubdomainAliasProxyPass,
+		RedirectLocationTmpl:         cfg.EndpointTemplates.RedirectLocation,
+		StaticLocationTmpl:           cfg.EndpointTemplates.StaticLocation,
+	}
+}
+
+// upstreamURL returns the upstream URL nginx requests for the given URI. The proxy_pass value contains variables, so
+// it is used as full URL and the captures of the rewrite regex are substituted.
+func upstreamURL(t *testing.T, e endpoint, uri string) string {
+	parts := strings.Fields(e.GetRewriteValue())
+	if len(parts) != 3 {
+		t.Fatalf("invalid rewrite value '%s'", e.GetRewriteValue())
+	}
+	re, err := regexp.Compile(parts[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := re.FindStringSubmatch(uri)
+	if m == nil {
+		t.Fatalf("rewrite '%s' does not match '%s'", parts[0], uri)
+	}
+	u := strings.Replace(e.GetProxyPassValue(), "$v"+e.ID, e.Host, -1)
+	for i := len(m) - 1; i > 0; i-- {
+		u = strings.Replace(u, "$"+strconv.Itoa(i), m[i], -1)
+	}
+	return strings.Replace(u, "$is_args$args", "", -1)
+}
+
+func TestGetServerDirectives(t *testing.T) {
+	templates := testTemplates(t)
+	port := 8080
+	tests := []struct {
+		name    string
+		eType   lib_model.EndpointType
+		intPath string
+		extPath string
+		uri     string
+		want    string
+	}{
+		{name: "root", eType: lib_model.SubdomainEndpoint, uri: "/index.html", want: "http://host:8080/index.html"},
+		{name: "root request", eType: lib_model.SubdomainEndpoint, uri: "/", want: "http://host:8080/"},
+		{name: "internal path", eType: lib_model.SubdomainEndpoint, intPath: "/app", uri: "/index.html", want: "http://host:8080/app/index.html"},
+		{name: "alias", eType: lib_model.SubdomainAliasEndpoint, extPath: "test", uri: "/a/b", want: "http://host:8080/a/b"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := newEndpoint(lib_model.Endpoint{
+				Type: tc.eType,
+				EndpointBase: lib_model.EndpointBase{
+					Ref:     "ref",
+					Host:    "host",
+					Port:    &port,
+					IntPath: tc.intPath,
+					ExtPath: tc.extPath,
+				},
+			}, templates)
+			if got := upstreamURL(t, e, tc.uri); got != tc.want {
+				t.Errorf("got upstream url '%s', want '%s'", got, tc.want)
+			}
+			directives, err := getServerDirectives(map[string]endpoint{e.ID: e}, authConfig{}, responseConfig{}, "80", "127.0.0.11")
+			if err != nil {
+				t.Fatal(err)
+			}
+			conf := dumper.DumpBlock(newBlock(directives), dumper.IndentedStyle)
+			for _, s := range []string{
+				"listen 80;",
+				"server_name " + e.GetLocationValue() + ";",
+				"resolver 127.0.0.11 " + resolverValid + ";",
+				"rewrite " + e.GetRewriteValue() + ";",
+				"proxy_pass " + e.GetProxyPassValue() + ";",
+			} {
+				if !strings.Contains(conf, s) {
+					t.Errorf("'%s' missing in\n%s", s, conf)
+				}
+			}
+		})
+	}
+}
+
+func TestSetEndpointsSubdomainDuplicate(t *testing.T) {
+	h := &Handler{templates: testTemplates(t), httpConfPath: "http.conf", endpoints: make(map[string]endpoint)}
+	_, err := h.setEndpoints([]lib_model.EndpointBase{
+		{Ref: "ref", Host: "host", ExtPath: "a", Subdomain: true},
+		{Ref: "ref", Host: "host", ExtPath: "b", Subdomain: true},
+	}, false, false)
+	if err == nil {
+		t.Error("expected error for subdomain endpoints with same server name")
+	}
+	endpoints, err := h.setEndpoints([]lib_model.EndpointBase{
+		{Ref: "ref", Host: "host", ExtPath: "a", Subdomain: true},
+		{Ref: "ref", Host: "host2", ExtPath: "a", Subdomain: true},
+	}, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(endpoints) != 1 {
+		t.Errorf("got %d endpoints, want 1", len(endpoints))
+	}
+}
@@ -0,0 +1,87 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"fmt"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"regexp"
+	"strings"
+)
+
+var placeholderRegex = regexp.MustCompile(`\{[a-z_]+\}`)
+
+var templatePlaceholders = map[string]struct{}{
+	varPlaceholder:  {},
+	portPlaceholder: {},
+	pathPlaceholder: {},
+	refPlaceholder:  {},
+}
+
+var templateNames = map[int]string{
+	locationTmpl:  "location",
+	rewriteTmpl:   "rewrite",
+	proxyPassTmpl: "proxy pass",
+}
+
+// checkTemplates ensures that a template is provided for every endpoint type and that templates only use known
+// placeholders.
+func checkTemplates(templates map[int]string) error {
+	for eType, tmplMap := range endpointTypeMap {
+		for tmplType, tmplID := range tmplMap {
+			tmpl, ok := templates[tmplID]
+			if !ok || tmpl == "" {
+				return fmt.Errorf("missing %s template for endpoint type '%d'", templateNames[tmplType], eType)
+			}
+			for _, p := range placeholderRegex.FindAllString(tmpl, -1) {
+				if _, ok := templatePlaceholders[p]; !ok {
+					return fmt.Errorf("unknown placeholder '%s' in %s template '%s'", p, templateNames[tmplType], tmpl)
+				}
+			}
+			if tmplType == proxyPassTmpl && !strings.Contains(tmpl, varPlaceholder) {
+				return fmt.Errorf("missing placeholder '%s' in %s template '%s'", varPlaceholder, templateNames[tmplType], tmpl)
+			}
+		}
+	}
+	return nil
+}
+
+// migrateEndpoints regenerates the IDs of endpoints whose location changed due to modified templates and updates the
+// parent IDs of their aliases accordingly.
+func migrateEndpoints(endpoints map[string]endpoint, templates map[int]string) (map[string]endpoint, error) {
+	idMap := make(map[string]string)
+	for id, e := range endpoints {
+		idMap[id] = util.GenHash(e.GetLocationValue())
+	}
+	migrated := make(map[string]endpoint)
+	for _, id := range sortedKeys(endpoints) {
+		e := endpoints[id]
+		nID := idMap[id]
+		if e2, ok := migrated[nID]; ok {
+			return nil, fmt.Errorf("endpoints '%s' and '%s' -> '%s' collide after template change", e2.Ref, e.Ref, e.GetLocationValue())
+		}
+		if nID != id {
+			util.Logger.Warningf("endpoint '%s' -> '%s' migrated to new id '%s'", id, e.GetLocationValue(), nID)
+		}
+		e.ID = nID
+		if pID, ok := idMap[e.ParentID]; ok {
+			e.ParentID = pID
+		}
+		migrated[nID] = newEndpoint(e.Endpoint, templates)
+	}
+	return migrated, nil
+}
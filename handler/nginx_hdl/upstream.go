@@ -0,0 +1,142 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package nginx_hdl
+
+import (
+	"errors"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"github.com/tufanbarisyildirim/gonginx/config"
+	"github.com/tufanbarisyildirim/gonginx/dumper"
+	"net"
+	"strconv"
+	"strings"
+)
+
+const (
+	upstreamPrefix   = "up_"
+	upstreamZoneSize = "64k"
+	resolverValid    = "valid=10s"
+)
+
+// initUpstreams re-renders the upstream config if it does not match the current endpoints. The upstream config contains
+// no endpoint information and is always derived from the endpoints.
+func (h *Handler) initUpstreams() error {
+	if h.upstreamConfPath == "" {
+		return nil
+	}
+	conf, err := parseConfig(h.upstreamConfPath)
+	if err != nil {
+		return err
+	}
+	directives := getUpstreamDirectives(h.endpoints, h.resolver)
+	if dumper.DumpBlock(newBlock(directives), dumper.IndentedStyle) != dumper.DumpConfig(conf, dumper.IndentedStyle) {
+		util.Logger.Warning("upstream config does not match endpoints, re-rendering upstreams ...")
+		return writeConfig(directives, h.upstreamConfPath)
+	}
+	return nil
+}
+
+func checkUpstream(upstream *lib_model.Upstream, upstreamConfPath string) error {
+	if upstream == nil {
+		return nil
+	}
+	if upstreamConfPath == "" {
+		return lib_model.NewInvalidInputError(errors.New("upstreams not supported, upstream config path not set"))
+	}
+	switch upstream.Method {
+	case "", lib_model.UpstreamRoundRobin, lib_model.UpstreamLeastConn, lib_model.UpstreamIPHash:
+	default:
+		return lib_model.NewInvalidInputError(fmt.Errorf("invalid upstream method '%s'", upstream.Method))
+	}
+	var primary bool
+	for _, target := range upstream.Targets {
+		if target.Host == "" || strings.ContainsAny(target.Host, " \t\r\n;{}'\"") {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid upstream host '%s'", target.Host))
+		}
+		if target.Port != nil && (*target.Port < 1 || *target.Port > 65535) {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid upstream port '%d'", *target.Port))
+		}
+		if target.Weight < 0 {
+			return lib_model.NewInvalidInputError(fmt.Errorf("invalid upstream weight '%d'", target.Weight))
+		}
+		if target.Backup {
+			if upstream.Method == lib_model.UpstreamIPHash {
+				return lib_model.NewInvalidInputError(errors.New("backup targets not supported by upstream method 'ip_hash'"))
+			}
+		} else {
+			primary = true
+		}
+	}
+	if !primary {
+		return lib_model.NewInvalidInputError(errors.New("upstream requires at least one non backup target"))
+	}
+	return nil
+}
+
+// genUpstreamName returns the name of the upstream block used by an endpoint. Aliases share the upstream of their
+// parent.
+func genUpstreamName(e lib_model.Endpoint) string {
+	if e.ParentID != "" {
+		return upstreamPrefix + e.ParentID
+	}
+	return upstreamPrefix + e.ID
+}
+
+// getUpstreamDirectives returns the upstream blocks of the given endpoints. The blocks must be included in the http
+// context. Targets are kept in a shared memory zone and resolved at runtime, so unavailable targets do not prevent
+// nginx from loading the config (requires nginx 1.27.3 or later).
+func getUpstreamDirectives(endpoints map[string]endpoint, resolver string) []config.IDirective {
+	var directives []config.IDirective
+	for _, id := range sortedKeys(endpoints) {
+		e := endpoints[id]
+		if e.Upstream == nil || e.ParentID != "" {
+			continue
+		}
+		name := genUpstreamName(e.Endpoint)
+		upDirectives := []config.IDirective{newDirective(zoneDirective, []string{name, upstreamZoneSize}, nil, nil)}
+		if resolver != "" {
+			upDirectives = append(upDirectives, newDirective(resolverDirective, []string{resolver, resolverValid}, nil, nil))
+		}
+		switch e.Upstream.Method {
+		case lib_model.UpstreamLeastConn:
+			upDirectives = append(upDirectives, newDirective(leastConnDirective, nil, nil, nil))
+		case lib_model.UpstreamIPHash:
+			upDirectives = append(upDirectives, newDirective(ipHashDirective, nil, nil, nil))
+		}
+		for _, target := range e.Upstream.Targets {
+			addr := target.Host
+			if target.Port != nil {
+				addr += ":" + strconv.FormatInt(int64(*target.Port), 10)
+			}
+			params := []string{addr}
+			if target.Weight > 0 {
+				params = append(params, "weight="+strconv.FormatInt(int64(target.Weight), 10))
+			}
+			if target.Backup {
+				params = append(params, "backup")
+			}
+			if net.ParseIP(target.Host) == nil {
+				params = append(params, "resolve")
+			}
+			upDirectives = append(upDirectives, newDirective(serverDirective, params, nil, nil))
+		}
+		directives = append(directives, newDirective(upstreamDirective, []string{name}, nil, newBlock(upDirectives)))
+	}
+	return directives
+}
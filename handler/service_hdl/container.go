@@ -24,6 +24,7 @@ import (
 	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
 	"github.com/SENERGY-Platform/mgw-core-manager/util"
 	job_hdl_lib "github.com/SENERGY-Platform/mgw-go-service-base/job-hdl/lib"
+	"io"
 	"net/http"
 	"sync"
 	"time"
@@ -77,7 +78,29 @@ func (h *CtrHandler) ExecCmd(ctx context.Context, cmd []string, tty bool, envVar
 	if err != nil {
 		return lib_model.NewInternalError(err)
 	}
-	return h.awaitJob(ctx, jID)
+	job, err := job_hdl_lib.Await(ctx, h.cewClient, jID, time.Second, h.httpTimeout, util.Logger)
+	if err != nil {
+		return lib_model.NewInternalError(err)
+	}
+	if job.Error != nil {
+		if job.Error.Code != nil && *job.Error.Code == http.StatusNotFound {
+			return lib_model.NewNotFoundError(errors.New(job.Error.Message))
+		}
+		return lib_model.NewInternalError(&util.ExecError{Cmd: cmd, Output: job.Error.Message})
+	}
+	return nil
+}
+
+func (h *CtrHandler) GetLog(ctx context.Context, maxLines int, since, until time.Time) (io.ReadCloser, error) {
+	rc, err := h.cewClient.GetContainerLog(ctx, h.containerName, cew_model.LogFilter{
+		MaxLines: maxLines,
+		Since:    since,
+		Until:    until,
+	})
+	if err != nil {
+		return nil, lib_model.NewInternalError(err)
+	}
+	return rc, nil
 }
 
 func (h *CtrHandler) awaitJob(ctx context.Context, jID string) error {
@@ -179,6 +179,14 @@ func (h *Handler) GetCtrHandler(name string) (*CtrHandler, error) {
 	return srv.CtrHandler, nil
 }
 
+func (h *Handler) GetCtrHandlers() map[string]*CtrHandler {
+	ctrHandlers := make(map[string]*CtrHandler)
+	for name, srv := range h.services {
+		ctrHandlers[name] = srv.CtrHandler
+	}
+	return ctrHandlers
+}
+
 func parseImageStr(s string) (name, tag string) {
 	parts := strings.Split(s, ":")
 	if len(parts) > 0 {
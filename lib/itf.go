@@ -28,17 +28,44 @@ type Api interface {
 	GetEndpoints(ctx context.Context, filter model.EndpointFilter) (map[string]model.Endpoint, error)
 	GetEndpoint(ctx context.Context, id string) (model.Endpoint, error)
 	SetEndpoint(ctx context.Context, endpoint model.EndpointBase) (string, error)
+	SetEndpointWithOptions(ctx context.Context, endpoint model.EndpointBase, options model.EndpointOptions) (string, error)
 	SetEndpoints(ctx context.Context, endpoints []model.EndpointBase) (string, error)
+	SetEndpointsWithOptions(ctx context.Context, endpoints []model.EndpointBase, options model.EndpointOptions) (string, error)
+	SetEndpointsDryRun(ctx context.Context, endpoints []model.EndpointBase) (model.EndpointChanges, error)
+	SetEndpointsDryRunWithOptions(ctx context.Context, endpoints []model.EndpointBase, options model.EndpointOptions) (model.EndpointChanges, error)
 	AddEndpointAlias(ctx context.Context, id, path string) (string, error)
+	AddEndpointAliasWithOptions(ctx context.Context, id, path string, options model.EndpointOptions) (string, error)
 	AddDefaultGuiEndpoint(ctx context.Context, id string) (string, error)
 	RemoveEndpoint(ctx context.Context, id string, restrictStd bool) (string, error)
 	RemoveEndpoints(ctx context.Context, filter model.EndpointFilter, restrictStd bool) (string, error)
+	RemoveEndpointsDryRun(ctx context.Context, filter model.EndpointFilter, restrictStd bool) (model.EndpointChanges, error)
+	SetEndpointMaintenance(ctx context.Context, id string, maintenance model.EndpointMaintenance) (string, error)
+	SetEndpointsMaintenance(ctx context.Context, filter model.EndpointFilter, maintenance model.EndpointMaintenance) (string, error)
+	GetEndpointHistory(ctx context.Context) ([]model.EndpointConfigVersion, error)
+	RestoreEndpoints(ctx context.Context, version int) (string, error)
+	GetStreamEndpoints(ctx context.Context, filter model.StreamEndpointFilter) (map[string]model.StreamEndpoint, error)
+	GetStreamEndpoint(ctx context.Context, id string) (model.StreamEndpoint, error)
+	SetStreamEndpoint(ctx context.Context, endpoint model.StreamEndpointBase) (string, error)
+	SetStreamEndpointWithOptions(ctx context.Context, endpoint model.StreamEndpointBase, options model.StreamEndpointOptions) (string, error)
+	RemoveStreamEndpoint(ctx context.Context, id string) (string, error)
+	RemoveStreamEndpoints(ctx context.Context, filter model.StreamEndpointFilter) (string, error)
 	GetCoreServices(ctx context.Context) (map[string]model.CoreService, error)
 	GetCoreService(ctx context.Context, name string) (model.CoreService, error)
 	RestartCoreService(ctx context.Context, name string) (string, error)
 	PurgeImages(ctx context.Context, repository, excludeTag string) (string, error)
 	ListLogs(ctx context.Context) ([]model.Log, error)
 	GetLog(ctx context.Context, id string, numOfLines int) (io.ReadCloser, error)
+	GetLogFiltered(ctx context.Context, id string, filter model.LogFilter) (io.ReadCloser, error)
+	ListLogFiles(ctx context.Context, id string) ([]model.LogFile, error)
+	AddLog(ctx context.Context, log model.LogReq) (string, error)
+	RemoveLog(ctx context.Context, id string) error
+	CreateDiagnostics(ctx context.Context) (string, error)
+	GetDiagnostics(ctx context.Context, id string) (io.ReadCloser, error)
+	GetCertificate(ctx context.Context) (model.CertInfo, error)
+	GetCACertificate(ctx context.Context) (string, error)
+	UploadCertificate(ctx context.Context, req model.CertUploadReq) (string, error)
+	RenewCertificate(ctx context.Context) (string, error)
+	GetSrvWarnings(ctx context.Context) ([]string, error)
 	job_hdl_lib.Api
 	srv_info_lib.Api
 }
@@ -23,22 +23,59 @@ const (
 )
 
 const (
-	CoreServicesPath   = "core-services"
-	RestartPath        = "restart"
-	RestrictedPath     = "restricted"
-	EndpointsPath      = "endpoints"
-	EndpointsBatchPath = "endpoints-batch"
-	AliasPath          = "alias"
-	CleanupPath        = "cleanup"
-	ImagesPath         = "images"
-	LogsPath           = "logs"
-	JobsPath           = "jobs"
-	JobsCancelPath     = "cancel"
-	SrvInfoPath        = "info"
+	CoreServicesPath         = "core-services"
+	RestartPath              = "restart"
+	RestrictedPath           = "restricted"
+	EndpointsPath            = "endpoints"
+	EndpointsBatchPath       = "endpoints-batch"
+	StreamEndpointsPath      = "stream-endpoints"
+	StreamEndpointsBatchPath = "stream-endpoints-batch"
+	AliasPath                = "alias"
+	HistoryPath              = "history"
+	RestorePath              = "restore"
+	MaintenancePath          = "maintenance"
+	CleanupPath              = "cleanup"
+	ImagesPath               = "images"
+	LogsPath                 = "logs"
+	LogFilesPath             = "files"
+	JobsPath                 = "jobs"
+	JobsCancelPath           = "cancel"
+	SrvInfoPath              = "info"
+	DiagnosticsPath          = "diagnostics"
+	CertificatePath          = "certificate"
+	CACertificatePath        = "ca"
+	RenewPath                = "renew"
 )
 
 const (
 	StandardEndpoint EndpointType = iota + 1
 	AliasEndpoint
 	DefaultGuiEndpoint
+	SubdomainEndpoint
+	SubdomainAliasEndpoint
+	RedirectEndpoint
+	StaticEndpoint
+)
+
+const (
+	RedirectMovedPermanently  = 301
+	RedirectFound             = 302
+	RedirectTemporaryRedirect = 307
+)
+
+const (
+	StreamProtocolTCP = "tcp"
+	StreamProtocolUDP = "udp"
+)
+
+const (
+	UpstreamRoundRobin = "round_robin"
+	UpstreamLeastConn  = "least_conn"
+	UpstreamIPHash     = "ip_hash"
+)
+
+const (
+	EndpointHealthy   = "healthy"
+	EndpointUnhealthy = "unhealthy"
+	EndpointUnknown   = "unknown"
 )
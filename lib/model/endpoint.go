@@ -21,20 +21,77 @@ import "time"
 type EndpointType = int
 
 type EndpointBase struct {
-	Ref       string            `json:"ref"`
-	Host      string            `json:"host"`
-	Port      *int              `json:"port"`
-	IntPath   string            `json:"int_path"`
-	ExtPath   string            `json:"ext_path"`
-	ProxyConf ProxyConfig       `json:"proxy_conf"`
-	StringSub StringSub         `json:"string_sub"`
-	Labels    map[string]string `json:"labels"`
+	Ref         string            `json:"ref"`
+	Host        string            `json:"host"`
+	Port        *int              `json:"port"`
+	IntPath     string            `json:"int_path"`
+	ExtPath     string            `json:"ext_path"`
+	Subdomain   bool              `json:"subdomain"` // route requests by host header via a dedicated server block instead of a path prefix
+	ProxyConf   ProxyConfig       `json:"proxy_conf"`
+	StringSub   StringSub         `json:"string_sub"`
+	Labels      map[string]string `json:"labels"`
+	Upstream    *Upstream         `json:"upstream,omitempty"` // load balance requests across multiple targets instead of host and port
+	Redirect    *Redirect         `json:"redirect,omitempty"` // respond with a redirect instead of proxying requests
+	Static      *StaticResponse   `json:"static,omitempty"`   // respond with a static response instead of proxying requests
+	HealthCheck *HealthCheck      `json:"health_check,omitempty"`
+	Auth        *AuthConfig       `json:"auth,omitempty"`
 }
 
 type ProxyConfig struct {
-	Headers     map[string]string `json:"headers"`
-	WebSocket   bool              `json:"websocket"`
-	ReadTimeout time.Duration     `json:"read_timeout"`
+	Headers           map[string]string `json:"headers"`
+	WebSocket         bool              `json:"websocket"`
+	ReadTimeout       time.Duration     `json:"read_timeout"`
+	ConnectTimeout    time.Duration     `json:"connect_timeout"`
+	SendTimeout       time.Duration     `json:"send_timeout"`
+	Buffering         *bool             `json:"buffering"`            // nil -> nginx default, disable for server-sent events
+	RequestBuffering  *bool             `json:"request_buffering"`    // nil -> nginx default
+	ResponseHeaders   map[string]string `json:"response_headers"`     // added to responses
+	HideHeaders       []string          `json:"hide_headers"`         // removed from upstream responses
+	ClientMaxBodySize string            `json:"client_max_body_size"` // nginx size value (e.g. 10m), 0 -> unlimited, empty -> nginx default
+	ReqRate           int               `json:"req_rate"`             // requests per second and client, 0 -> unlimited
+	ReqBurst          int               `json:"req_burst"`            // requests exceeding the rate that are delayed before rejecting
+	ConnLimit         int               `json:"conn_limit"`           // concurrent connections per client, 0 -> unlimited
+}
+
+type Upstream struct {
+	Method  string           `json:"method"` // round_robin, least_conn or ip_hash, empty -> round_robin
+	Targets []UpstreamTarget `json:"targets"`
+}
+
+type UpstreamTarget struct {
+	Host   string `json:"host"`
+	Port   *int   `json:"port"`
+	Weight int    `json:"weight"` // 0 -> nginx default
+	Backup bool   `json:"backup"` // only receives requests if all other targets are unavailable
+}
+
+type Redirect struct {
+	Target string `json:"target"` // absolute URL or path, nginx variables like $request_uri can be used
+	Status int    `json:"status"` // 301, 302 or 307, 0 -> 302
+}
+
+type StaticResponse struct {
+	Status      int    `json:"status"`       // 0 -> 200
+	ContentType string `json:"content_type"` // empty -> text/plain
+	Body        string `json:"body"`
+	File        string `json:"file"` // path relative to the configured static files directory, used instead of body
+}
+
+type HealthCheck struct {
+	Path           string        `json:"path"`            // request path on the target, empty -> int_path
+	Interval       time.Duration `json:"interval"`        // 0 -> default interval
+	ExpectedStatus int           `json:"expected_status"` // 0 -> any 2xx or 3xx status
+}
+
+type EndpointOptions struct {
+	Force bool // replace endpoints of other references and ignore location conflicts
+}
+
+type AuthConfig struct {
+	Allow       []string          `json:"allow"`        // addresses or CIDRs, if set all other addresses are denied
+	Deny        []string          `json:"deny"`         // addresses or CIDRs
+	BasicAuth   map[string]string `json:"basic_auth"`   // user:password, passwords are stored hashed and omitted in responses, empty password -> keep current password
+	AuthRequest bool              `json:"auth_request"` // authorize requests via the configured auth service
 }
 
 type StringSub struct {
@@ -44,10 +101,12 @@ type StringSub struct {
 }
 
 type Endpoint struct {
-	ID       string       `json:"id"`
-	ParentID string       `json:"parent_id"`
-	Type     EndpointType `json:"type"`
-	Location string       `json:"location,omitempty"`
+	ID          string               `json:"id"`
+	ParentID    string               `json:"parent_id"`
+	Type        EndpointType         `json:"type"`
+	Location    string               `json:"location,omitempty"`
+	Status      string               `json:"status,omitempty"`
+	Maintenance *EndpointMaintenance `json:"maintenance,omitempty"` // set while the endpoint is in maintenance mode
 	EndpointBase
 }
 
@@ -58,6 +117,52 @@ type EndpointFilter struct {
 	Labels map[string]string
 }
 
+type EndpointChanges struct {
+	Added    []string `json:"added"`
+	Replaced []string `json:"replaced"`
+	Removed  []string `json:"removed"`
+	Diff     string   `json:"diff"` // unified diff of the generated nginx config
+}
+
+type EndpointConfigVersion struct {
+	Version   int       `json:"version"`
+	Timestamp time.Time `json:"timestamp"`
+	JobID     string    `json:"job_id"`
+	Added     []string  `json:"added"`
+	Replaced  []string  `json:"replaced"`
+	Removed   []string  `json:"removed"`
+}
+
+type EndpointMaintenance struct {
+	Enabled    bool          `json:"enabled"`
+	RetryAfter time.Duration `json:"retry_after"` // value of the Retry-After header, 0 -> header omitted
+}
+
 type EndpointAliasReq struct {
 	Path string `json:"path"`
 }
+
+type StreamEndpointBase struct {
+	Ref      string            `json:"ref"`
+	Host     string            `json:"host"`
+	Port     int               `json:"port"`
+	ExtPort  int               `json:"ext_port"` // 0 -> allocate free port from configured range
+	Protocol string            `json:"protocol"` // tcp or udp, empty -> tcp
+	Labels   map[string]string `json:"labels"`
+}
+
+type StreamEndpoint struct {
+	ID string `json:"id"`
+	StreamEndpointBase
+}
+
+type StreamEndpointOptions struct {
+	Force bool // replace stream endpoints of other references or targets using the same port
+}
+
+type StreamEndpointFilter struct {
+	IDs      []string
+	Ref      string
+	Protocol string
+	Labels   map[string]string
+}
@@ -16,7 +16,47 @@
 
 package model
 
+import "time"
+
 type Log struct {
 	ID          string `json:"id"`
 	ServiceName string `json:"service_name"`
 }
+
+type LogReq struct {
+	ServiceName string `json:"service_name"` // letters, digits, '_', '.' and '-'
+	Path        string `json:"path"`
+	TimeLayout  string `json:"time_layout"`
+	Format      string `json:"format"`      // text (default) or json
+	MaxSize     int64  `json:"max_size"`    // bytes, 0 -> no rotation
+	MaxFiles    int    `json:"max_files"`   // rotated files to keep, 0 -> keep all
+	MoveCreate  bool   `json:"move_create"` // move the file instead of copy and truncate, only for writers that reopen the file on their own
+}
+
+type LogFile struct {
+	Name       string    `json:"name"`
+	Size       int64     `json:"size"`
+	ModTime    time.Time `json:"mod_time"`
+	Compressed bool      `json:"compressed"`
+}
+
+type LogFilter struct {
+	MaxLines    int
+	Follow      bool
+	Since       time.Time
+	Until       time.Time
+	Grep        string
+	GrepRegex   bool
+	Generations int               // number of rotated files to include
+	Level       string            // json logs only
+	FieldFilter map[string]string // json logs only
+	Fields      []string          // extra fields of normalized entries, empty -> all
+	Normalize   bool
+}
+
+type LogEntry struct {
+	Time    *time.Time     `json:"time"`
+	Level   string         `json:"level"`
+	Message string         `json:"message"`
+	Fields  map[string]any `json:"fields,omitempty"`
+}
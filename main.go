@@ -23,7 +23,10 @@ import (
 	"github.com/SENERGY-Platform/go-cc-job-handler/ccjh"
 	sb_logger "github.com/SENERGY-Platform/go-service-base/logger"
 	cew_client "github.com/SENERGY-Platform/mgw-container-engine-wrapper/client"
+	"github.com/SENERGY-Platform/mgw-core-manager/handler/cert_hdl"
 	"github.com/SENERGY-Platform/mgw-core-manager/handler/cleanup_hdl"
+	"github.com/SENERGY-Platform/mgw-core-manager/handler/diag_hdl"
+	"github.com/SENERGY-Platform/mgw-core-manager/handler/health_hdl"
 	"github.com/SENERGY-Platform/mgw-core-manager/handler/http_hdl"
 	"github.com/SENERGY-Platform/mgw-core-manager/handler/kratos_hdl"
 	"github.com/SENERGY-Platform/mgw-core-manager/handler/log_hdl"
@@ -39,23 +42,13 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"syscall"
 	"time"
 )
 
 var version string
 
-var endpointTemplates = map[int]string{
-	nginx_hdl.StandardLocationTmpl:    "/endpoints/deployment/{ref}/{path}",
-	nginx_hdl.StandardRewriteTmpl:     "/endpoints/deployment/{ref}/{path}(.*) /$1 break",
-	nginx_hdl.StandardProxyPassTmpl:   "http://{var}{port}{path}$1$is_args$args",
-	nginx_hdl.DefaultGuiLocationTmpl:  "/",
-	nginx_hdl.DefaultGuiProxyPassTmpl: "http://{var}{port}{path}",
-	nginx_hdl.AliasLocationTmpl:       "/endpoints/alias/{path}",
-	nginx_hdl.AliasRewriteTmpl:        "/endpoints/alias/{path}(.*) /$1 break",
-	nginx_hdl.AliasProxyPassTmpl:      "http://{var}{port}{path}$1$is_args$args",
-}
-
 func main() {
 	srvInfoHdl := srv_info_hdl.New("core-manager", version)
 
@@ -130,21 +123,63 @@ func main() {
 		return
 	}
 
-	gwEndpointHdl := nginx_hdl.New(gwCtrHdl, config.EndpointsConfPath, endpointTemplates)
+	endpointTemplates := map[int]string{
+		nginx_hdl.StandardLocationTmpl:         config.EndpointTemplates.StandardLocation,
+		nginx_hdl.StandardRewriteTmpl:          config.EndpointTemplates.StandardRewrite,
+		nginx_hdl.StandardProxyPassTmpl:        config.EndpointTemplates.StandardProxyPass,
+		nginx_hdl.DefaultGuiLocationTmpl:       config.EndpointTemplates.DefaultGuiLocation,
+		nginx_hdl.DefaultGuiProxyPassTmpl:      config.EndpointTemplates.DefaultGuiProxyPass,
+		nginx_hdl.AliasLocationTmpl:            config.EndpointTemplates.AliasLocation,
+		nginx_hdl.AliasRewriteTmpl:             config.EndpointTemplates.AliasRewrite,
+		nginx_hdl.AliasProxyPassTmpl:           config.EndpointTemplates.AliasProxyPass,
+		nginx_hdl.SubdomainServerNameTmpl:      config.EndpointTemplates.SubdomainServerName,
+		nginx_hdl.SubdomainRewriteTmpl:         config.EndpointTemplates.SubdomainRewrite,
+		nginx_hdl.SubdomainProxyPassTmpl:       config.EndpointTemplates.SubdomainProxyPass,
+		nginx_hdl.SubdomainAliasServerNameTmpl: config.EndpointTemplates.SubdomainAliasServerName,
+		nginx_hdl.SubdomainAliasRewriteTmpl:    config.EndpointTemplates.SubdomainAliasRewrite,
+		nginx_hdl.SubdomainAliasProxyPassTmpl:  config.EndpointTemplates.SubdomainAliasProxyPass,
+		nginx_hdl.RedirectLocationTmpl:         config.EndpointTemplates.RedirectLocation,
+		nginx_hdl.StaticLocationTmpl:           config.EndpointTemplates.StaticLocation,
+	}
+
+	gwEndpointHdl := nginx_hdl.New(gwCtrHdl, nginx_hdl.Config{
+		ConfPath:         config.EndpointsConfPath,
+		HttpConfPath:     config.EndpointsHttpConfPath,
+		UpstreamConfPath: config.EndpointsUpstreamConfPath,
+		Resolver:         config.EndpointsResolver,
+		ServerListen:     config.EndpointsServerListen,
+		Templates:        endpointTemplates,
+		HistoryPath:      config.EndpointsHistory.Path,
+		HistorySize:      config.EndpointsHistory.Size,
+		HtpasswdPath:     config.EndpointsAuth.HtpasswdPath,
+		AuthRequestPath:  config.EndpointsAuth.AuthRequestPath,
+		StaticPath:       config.EndpointsStaticPath,
+		MaintenancePage:  config.EndpointsMaintenancePage,
+		StreamConfPath:   config.EndpointsStream.ConfPath,
+		StreamPortMin:    config.EndpointsStream.PortMin,
+		StreamPortMax:    config.EndpointsStream.PortMax,
+	})
 	if err = gwEndpointHdl.Init(); err != nil {
 		util.Logger.Error(err)
 		ec = 1
 		return
 	}
 
-	logConfig, err := log_hdl.ReadConfig(config.LogHandler.Path)
-	if err != nil {
+	certHdl := cert_hdl.New(gwCtrHdl, config.Certs.Path, config.Certs.SslConfPath, config.Certs.Hostnames, config.Certs.IPs, time.Duration(config.Certs.Validity), time.Duration(config.Certs.RenewBefore), time.Duration(config.Certs.WarnBefore))
+	if err = certHdl.Init(); err != nil {
 		util.Logger.Error(err)
 		ec = 1
 		return
 	}
-	logHdl, err := log_hdl.New(logConfig, config.LogHandler.BufferSize)
-	if err != nil {
+
+	endpointHealthHdl := health_hdl.New(gwEndpointHdl, time.Duration(config.EndpointHealth.Timeout))
+
+	logCtrHandlers := make(map[string]log_hdl.ContainerHandler)
+	for name, ctrHdl := range coreServiceHdl.GetCtrHandlers() {
+		logCtrHandlers[name] = ctrHdl
+	}
+	logHdl := log_hdl.New(config.LogHandler.Path, config.LogHandler.AllowedDirs, logCtrHandlers, config.LogHandler.BufferSize, time.Duration(config.LogHandler.PollInterval))
+	if err = logHdl.Init(); err != nil {
 		util.Logger.Error(err)
 		ec = 1
 		return
@@ -163,6 +198,13 @@ func main() {
 	jobHandler := job_hdl.New(jobCtx, ccHandler)
 	purgeJobsHdl := job_hdl.NewPurgeJobsHandler(jobHandler, time.Duration(config.Jobs.PJHInterval), time.Duration(config.Jobs.MaxAge))
 
+	diagHdl := diag_hdl.New(logHdl, coreServiceHdl, gwEndpointHdl, jobHandler, config, config.Diagnostics.WorkPath, config.Diagnostics.MaxBundles, config.Diagnostics.LogLines)
+	if err = diagHdl.Init(); err != nil {
+		util.Logger.Error(err)
+		ec = 1
+		return
+	}
+
 	wtchdg.RegisterStopFunc(func() error {
 		ccHandler.Stop()
 		jobCF()
@@ -183,7 +225,7 @@ func main() {
 		return nil
 	})
 
-	coreManager := manager.New(coreServiceHdl, gwEndpointHdl, cleanupHdl, logHdl, jobHandler, srvInfoHdl)
+	coreManager := manager.New(coreServiceHdl, gwEndpointHdl, endpointHealthHdl, cleanupHdl, logHdl, diagHdl, certHdl, jobHandler, srvInfoHdl)
 
 	httpHandler, err := http_hdl.New(coreManager, map[string]string{
 		lib_model.HeaderApiVer:  srvInfoHdl.GetVersion(),
@@ -231,6 +273,40 @@ func main() {
 
 	kratosHdl.Start()
 
+	if config.LogHandler.WatchInterval > 0 {
+		logWatchCtx, logWatchCf := context.WithCancel(context.Background())
+		wtchdg.RegisterHealthFunc(logHdl.Running)
+		wtchdg.RegisterStopFunc(func() error {
+			logWatchCf()
+			logHdl.Wait()
+			return nil
+		})
+		logHdl.Start(logWatchCtx, time.Duration(config.LogHandler.WatchInterval))
+	}
+
+	if config.EndpointHealth.Interval > 0 {
+		healthCtx, healthCf := context.WithCancel(context.Background())
+		wtchdg.RegisterHealthFunc(endpointHealthHdl.Running)
+		wtchdg.RegisterStopFunc(func() error {
+			healthCf()
+			endpointHealthHdl.Wait()
+			return nil
+		})
+		endpointHealthHdl.Start(healthCtx, time.Duration(config.EndpointHealth.Interval))
+	}
+
+	sigHupChan := make(chan os.Signal, 1)
+	signal.Notify(sigHupChan, syscall.SIGHUP)
+	go func() {
+		for range sigHupChan {
+			if err := logHdl.Reload(); err != nil {
+				util.Logger.Errorf("reloading log handler config failed: %s", err)
+				continue
+			}
+			util.Logger.Info("log handler config reloaded")
+		}
+	}()
+
 	wtchdg.Start()
 
 	err = ccHandler.RunAsync(config.Jobs.MaxNumber, time.Duration(config.Jobs.JHInterval*1000))
@@ -246,6 +322,14 @@ func main() {
 		util.Logger.Error(err)
 	}
 
+	if config.LogHandler.RotationInterval > 0 {
+		coreManager.StartLogRotation(jobCtx, time.Duration(config.LogHandler.RotationInterval))
+	}
+
+	if config.Certs.Path != "" && config.Certs.CheckInterval > 0 {
+		coreManager.StartCertRenewal(jobCtx, time.Duration(config.Certs.CheckInterval))
+	}
+
 	go func() {
 		defer srvCF()
 		util.Logger.Info("starting http server ...")
@@ -0,0 +1,74 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
+	"time"
+)
+
+func (m *Manager) GetCertificate(ctx context.Context) (lib_model.CertInfo, error) {
+	return m.certHdl.Get(ctx)
+}
+
+func (m *Manager) GetCACertificate(ctx context.Context) (string, error) {
+	return m.certHdl.GetCA(ctx)
+}
+
+func (m *Manager) UploadCertificate(ctx context.Context, req lib_model.CertUploadReq) (string, error) {
+	return m.jobHandler.Create(ctx, "upload certificate", func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		err := m.certHdl.Upload(ctx, req)
+		if err == nil {
+			err = ctx.Err()
+		}
+		return nil, err
+	})
+}
+
+func (m *Manager) RenewCertificate(ctx context.Context) (string, error) {
+	return m.jobHandler.Create(ctx, "renew certificate", func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		err := m.certHdl.Renew(ctx)
+		if err == nil {
+			err = ctx.Err()
+		}
+		return nil, err
+	})
+}
+
+func (m *Manager) StartCertRenewal(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if !m.certHdl.NeedsRenewal() {
+					continue
+				}
+				if _, err := m.RenewCertificate(ctx); err != nil {
+					util.Logger.Error("renew certificate:", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
@@ -0,0 +1,42 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"io"
+	"path"
+)
+
+func (m *Manager) CreateDiagnostics(ctx context.Context) (string, error) {
+	return m.jobHandler.Create(ctx, "create diagnostics bundle", func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		id, err := m.diagHdl.Create(ctx)
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return path.Join(lib_model.DiagnosticsPath, id), nil
+	})
+}
+
+func (m *Manager) GetDiagnostics(ctx context.Context, id string) (io.ReadCloser, error) {
+	return m.diagHdl.Get(ctx, id)
+}
@@ -18,80 +18,141 @@ package manager
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
 )
 
 func (m *Manager) GetEndpoints(ctx context.Context, filter lib_model.EndpointFilter) (map[string]lib_model.Endpoint, error) {
-	return m.gwEndpointHdl.List(ctx, filter)
+	endpoints, err := m.gwEndpointHdl.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	for id, endpoint := range endpoints {
+		endpoint.Status = m.healthHdl.GetStatus(endpoint)
+		endpoints[id] = endpoint
+	}
+	return endpoints, nil
 }
 
 func (m *Manager) GetEndpoint(ctx context.Context, id string) (lib_model.Endpoint, error) {
-	return m.gwEndpointHdl.Get(ctx, id)
+	endpoint, err := m.gwEndpointHdl.Get(ctx, id)
+	if err != nil {
+		return lib_model.Endpoint{}, err
+	}
+	endpoint.Status = m.healthHdl.GetStatus(endpoint)
+	return endpoint, nil
 }
 
 func (m *Manager) SetEndpoint(ctx context.Context, endpoint lib_model.EndpointBase) (string, error) {
-	return m.jobHandler.Create(ctx, fmt.Sprintf("set endpoint '%+v'", endpoint), func(ctx context.Context, cf context.CancelFunc) (any, error) {
-		defer cf()
-		err := m.gwEndpointHdl.Set(ctx, endpoint)
-		if err == nil {
-			err = ctx.Err()
-		}
-		return nil, err
+	return m.SetEndpointWithOptions(ctx, endpoint, lib_model.EndpointOptions{})
+}
+
+func (m *Manager) SetEndpointWithOptions(ctx context.Context, endpoint lib_model.EndpointBase, options lib_model.EndpointOptions) (string, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("set endpoint '%+v'", endpoint), func(ctx context.Context) error {
+		return m.gwEndpointHdl.Set(ctx, endpoint, options.Force)
 	})
 }
 
 func (m *Manager) SetEndpoints(ctx context.Context, endpoints []lib_model.EndpointBase) (string, error) {
-	return m.jobHandler.Create(ctx, fmt.Sprintf("set endpoints '%+v'", endpoints), func(ctx context.Context, cf context.CancelFunc) (any, error) {
-		defer cf()
-		err := m.gwEndpointHdl.SetList(ctx, endpoints)
-		if err == nil {
-			err = ctx.Err()
-		}
-		return nil, err
+	return m.SetEndpointsWithOptions(ctx, endpoints, lib_model.EndpointOptions{})
+}
+
+func (m *Manager) SetEndpointsWithOptions(ctx context.Context, endpoints []lib_model.EndpointBase, options lib_model.EndpointOptions) (string, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("set endpoints '%+v'", endpoints), func(ctx context.Context) error {
+		return m.gwEndpointHdl.SetList(ctx, endpoints, options.Force)
 	})
 }
 
+func (m *Manager) SetEndpointsDryRun(ctx context.Context, endpoints []lib_model.EndpointBase) (lib_model.EndpointChanges, error) {
+	return m.SetEndpointsDryRunWithOptions(ctx, endpoints, lib_model.EndpointOptions{})
+}
+
+func (m *Manager) SetEndpointsDryRunWithOptions(ctx context.Context, endpoints []lib_model.EndpointBase, options lib_model.EndpointOptions) (lib_model.EndpointChanges, error) {
+	return m.gwEndpointHdl.SetListDryRun(ctx, endpoints, options.Force)
+}
+
 func (m *Manager) AddEndpointAlias(ctx context.Context, id, path string) (string, error) {
-	return m.jobHandler.Create(ctx, fmt.Sprintf("add alias for endpoint '%s'", id), func(ctx context.Context, cf context.CancelFunc) (any, error) {
-		defer cf()
-		err := m.gwEndpointHdl.AddAlias(ctx, id, path)
-		if err == nil {
-			err = ctx.Err()
-		}
-		return nil, err
+	return m.AddEndpointAliasWithOptions(ctx, id, path, lib_model.EndpointOptions{})
+}
+
+func (m *Manager) AddEndpointAliasWithOptions(ctx context.Context, id, path string, options lib_model.EndpointOptions) (string, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("add alias for endpoint '%s'", id), func(ctx context.Context) error {
+		return m.gwEndpointHdl.AddAlias(ctx, id, path, options.Force)
 	})
 }
 
 func (m *Manager) AddDefaultGuiEndpoint(ctx context.Context, id string) (string, error) {
-	return m.jobHandler.Create(ctx, fmt.Sprintf("add endpoint '%s' as default gui", id), func(ctx context.Context, cf context.CancelFunc) (any, error) {
-		defer cf()
-		err := m.gwEndpointHdl.AddDefaultGui(ctx, id)
-		if err == nil {
-			err = ctx.Err()
-		}
-		return nil, err
+	return m.createEndpointJob(ctx, fmt.Sprintf("add endpoint '%s' as default gui", id), func(ctx context.Context) error {
+		return m.gwEndpointHdl.AddDefaultGui(ctx, id)
 	})
 }
 
 func (m *Manager) RemoveEndpoint(ctx context.Context, id string, restrictStd bool) (string, error) {
-	return m.jobHandler.Create(ctx, fmt.Sprintf("remove endpoint '%s'", id), func(ctx context.Context, cf context.CancelFunc) (any, error) {
-		defer cf()
-		err := m.gwEndpointHdl.Remove(ctx, id, restrictStd)
-		if err == nil {
-			err = ctx.Err()
-		}
-		return nil, err
+	return m.createEndpointJob(ctx, fmt.Sprintf("remove endpoint '%s'", id), func(ctx context.Context) error {
+		return m.gwEndpointHdl.Remove(ctx, id, restrictStd)
 	})
 }
 
 func (m *Manager) RemoveEndpoints(ctx context.Context, filter lib_model.EndpointFilter, restrictStd bool) (string, error) {
-	return m.jobHandler.Create(ctx, fmt.Sprintf("remove endpoints '%+v'", filter), func(ctx context.Context, cf context.CancelFunc) (any, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("remove endpoints '%+v'", filter), func(ctx context.Context) error {
+		return m.gwEndpointHdl.RemoveAll(ctx, filter, restrictStd)
+	})
+}
+
+func (m *Manager) SetEndpointMaintenance(ctx context.Context, id string, maintenance lib_model.EndpointMaintenance) (string, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("set maintenance mode '%+v' for endpoint '%s'", maintenance, id), func(ctx context.Context) error {
+		return m.gwEndpointHdl.SetMaintenance(ctx, id, maintenance)
+	})
+}
+
+func (m *Manager) SetEndpointsMaintenance(ctx context.Context, filter lib_model.EndpointFilter, maintenance lib_model.EndpointMaintenance) (string, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("set maintenance mode '%+v' for endpoints '%+v'", maintenance, filter), func(ctx context.Context) error {
+		return m.gwEndpointHdl.SetMaintenanceAll(ctx, filter, maintenance)
+	})
+}
+
+func (m *Manager) GetEndpointHistory(ctx context.Context) ([]lib_model.EndpointConfigVersion, error) {
+	return m.gwEndpointHdl.ListHistory(ctx)
+}
+
+func (m *Manager) RestoreEndpoints(ctx context.Context, version int) (string, error) {
+	return m.createEndpointJob(ctx, fmt.Sprintf("restore endpoints version '%d'", version), func(ctx context.Context) error {
+		return m.gwEndpointHdl.Restore(ctx, version)
+	})
+}
+
+func (m *Manager) RemoveEndpointsDryRun(ctx context.Context, filter lib_model.EndpointFilter, restrictStd bool) (lib_model.EndpointChanges, error) {
+	return m.gwEndpointHdl.RemoveAllDryRun(ctx, filter, restrictStd)
+}
+
+// createEndpointJob creates a job and passes its ID to the endpoint handler via the context, so it can be referenced in
+// the endpoint config history.
+func (m *Manager) createEndpointJob(ctx context.Context, desc string, f func(ctx context.Context) error) (string, error) {
+	idChan := make(chan string, 1)
+	jID, err := m.jobHandler.Create(ctx, desc, func(ctx context.Context, cf context.CancelFunc) (any, error) {
 		defer cf()
-		err := m.gwEndpointHdl.RemoveAll(ctx, filter, restrictStd)
+		var jID string
+		var ok bool
+		select {
+		case jID, ok = <-idChan:
+			if !ok {
+				return nil, errors.New("job creation failed")
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		err := f(util.ContextWithJobID(ctx, jID))
 		if err == nil {
 			err = ctx.Err()
 		}
 		return nil, err
 	})
+	if err != nil {
+		close(idChan)
+		return "", err
+	}
+	idChan <- jID
+	return jID, nil
 }
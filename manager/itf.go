@@ -25,12 +25,27 @@ import (
 type GatewayEndpointHandler interface {
 	List(ctx context.Context, filter lib_model.EndpointFilter) (map[string]lib_model.Endpoint, error)
 	Get(ctx context.Context, id string) (lib_model.Endpoint, error)
-	Set(ctx context.Context, endpoint lib_model.EndpointBase) error
-	SetList(ctx context.Context, endpoints []lib_model.EndpointBase) error
-	AddAlias(ctx context.Context, id, path string) error
+	Set(ctx context.Context, endpoint lib_model.EndpointBase, force bool) error
+	SetList(ctx context.Context, endpoints []lib_model.EndpointBase, force bool) error
+	SetListDryRun(ctx context.Context, endpoints []lib_model.EndpointBase, force bool) (lib_model.EndpointChanges, error)
+	AddAlias(ctx context.Context, id, path string, force bool) error
 	AddDefaultGui(ctx context.Context, id string) error
 	Remove(ctx context.Context, id string, restrictStd bool) error
 	RemoveAll(ctx context.Context, filter lib_model.EndpointFilter, restrictStd bool) error
+	RemoveAllDryRun(ctx context.Context, filter lib_model.EndpointFilter, restrictStd bool) (lib_model.EndpointChanges, error)
+	SetMaintenance(ctx context.Context, id string, maintenance lib_model.EndpointMaintenance) error
+	SetMaintenanceAll(ctx context.Context, filter lib_model.EndpointFilter, maintenance lib_model.EndpointMaintenance) error
+	ListHistory(ctx context.Context) ([]lib_model.EndpointConfigVersion, error)
+	Restore(ctx context.Context, version int) error
+	ListStreams(ctx context.Context, filter lib_model.StreamEndpointFilter) (map[string]lib_model.StreamEndpoint, error)
+	GetStream(ctx context.Context, id string) (lib_model.StreamEndpoint, error)
+	SetStream(ctx context.Context, endpoint lib_model.StreamEndpointBase, force bool) (string, error)
+	RemoveStream(ctx context.Context, id string) error
+	RemoveAllStreams(ctx context.Context, filter lib_model.StreamEndpointFilter) error
+}
+
+type EndpointHealthHandler interface {
+	GetStatus(endpoint lib_model.Endpoint) string
 }
 
 type CoreServiceHandler interface {
@@ -45,5 +60,24 @@ type CleanupHandler interface {
 
 type LogHandler interface {
 	List(ctx context.Context) ([]lib_model.Log, error)
-	GetReader(ctx context.Context, id string, numOfLines int) (io.ReadCloser, error)
+	GetReader(ctx context.Context, id string, filter lib_model.LogFilter) (io.ReadCloser, error)
+	ListFiles(ctx context.Context, id string) ([]lib_model.LogFile, error)
+	Add(ctx context.Context, log lib_model.LogReq) (string, error)
+	Remove(ctx context.Context, id string) error
+	Rotate(ctx context.Context) error
+	NeedsRotation() bool
+}
+
+type CertHandler interface {
+	Get(ctx context.Context) (lib_model.CertInfo, error)
+	GetCA(ctx context.Context) (string, error)
+	Upload(ctx context.Context, req lib_model.CertUploadReq) error
+	Renew(ctx context.Context) error
+	NeedsRenewal() bool
+	Warnings() []string
+}
+
+type DiagnosticsHandler interface {
+	Create(ctx context.Context) (string, error)
+	Get(ctx context.Context, id string) (io.ReadCloser, error)
 }
@@ -19,7 +19,9 @@ package manager
 import (
 	"context"
 	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+	"github.com/SENERGY-Platform/mgw-core-manager/util"
 	"io"
+	"time"
 )
 
 func (m *Manager) ListLogs(ctx context.Context) ([]lib_model.Log, error) {
@@ -27,5 +29,50 @@ func (m *Manager) ListLogs(ctx context.Context) ([]lib_model.Log, error) {
 }
 
 func (m *Manager) GetLog(ctx context.Context, id string, numOfLines int) (io.ReadCloser, error) {
-	return m.logHandler.GetReader(ctx, id, numOfLines)
+	return m.GetLogFiltered(ctx, id, lib_model.LogFilter{MaxLines: numOfLines})
+}
+
+func (m *Manager) GetLogFiltered(ctx context.Context, id string, filter lib_model.LogFilter) (io.ReadCloser, error) {
+	return m.logHandler.GetReader(ctx, id, filter)
+}
+
+func (m *Manager) ListLogFiles(ctx context.Context, id string) ([]lib_model.LogFile, error) {
+	return m.logHandler.ListFiles(ctx, id)
+}
+
+func (m *Manager) AddLog(ctx context.Context, log lib_model.LogReq) (string, error) {
+	return m.logHandler.Add(ctx, log)
+}
+
+func (m *Manager) RemoveLog(ctx context.Context, id string) error {
+	return m.logHandler.Remove(ctx, id)
+}
+
+// StartLogRotation periodically creates a rotation job if at least one log exceeds its maximum size.
+func (m *Manager) StartLogRotation(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if !m.logHandler.NeedsRotation() {
+					continue
+				}
+				_, err := m.jobHandler.Create(ctx, "rotate logs", func(ctx context.Context, cf context.CancelFunc) (any, error) {
+					defer cf()
+					err := m.logHandler.Rotate(ctx)
+					if err == nil {
+						err = ctx.Err()
+					}
+					return nil, err
+				})
+				if err != nil {
+					util.Logger.Error("rotate logs:", err)
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
 }
@@ -24,18 +24,24 @@ import (
 type Manager struct {
 	coreSrvHdl    CoreServiceHandler
 	gwEndpointHdl GatewayEndpointHandler
+	healthHdl     EndpointHealthHandler
 	cleanupHdl    CleanupHandler
 	logHandler    LogHandler
+	diagHdl       DiagnosticsHandler
+	certHdl       CertHandler
 	jobHandler    job_hdl.JobHandler
 	srvInfoHdl    srv_info_hdl.SrvInfoHandler
 }
 
-func New(coreServiceHandler CoreServiceHandler, gwEndpointHdl GatewayEndpointHandler, cleanupHdl CleanupHandler, logHandler LogHandler, jobHandler job_hdl.JobHandler, srvInfoHandler srv_info_hdl.SrvInfoHandler) *Manager {
+func New(coreServiceHandler CoreServiceHandler, gwEndpointHdl GatewayEndpointHandler, healthHdl EndpointHealthHandler, cleanupHdl CleanupHandler, logHandler LogHandler, diagHdl DiagnosticsHandler, certHdl CertHandler, jobHandler job_hdl.JobHandler, srvInfoHandler srv_info_hdl.SrvInfoHandler) *Manager {
 	return &Manager{
 		coreSrvHdl:    coreServiceHandler,
 		gwEndpointHdl: gwEndpointHdl,
+		healthHdl:     healthHdl,
 		cleanupHdl:    cleanupHdl,
 		logHandler:    logHandler,
+		diagHdl:       diagHdl,
+		certHdl:       certHdl,
 		jobHandler:    jobHandler,
 		srvInfoHdl:    srvInfoHandler,
 	}
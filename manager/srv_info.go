@@ -24,3 +24,7 @@ import (
 func (m *Manager) GetSrvInfo(_ context.Context) srv_info_lib.SrvInfo {
 	return m.srvInfoHdl.GetInfo()
 }
+
+func (m *Manager) GetSrvWarnings(_ context.Context) ([]string, error) {
+	return m.certHdl.Warnings(), nil
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright 2025 InfAI (CC SES)
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *    http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	lib_model "github.com/SENERGY-Platform/mgw-core-manager/lib/model"
+)
+
+func (m *Manager) GetStreamEndpoints(ctx context.Context, filter lib_model.StreamEndpointFilter) (map[string]lib_model.StreamEndpoint, error) {
+	return m.gwEndpointHdl.ListStreams(ctx, filter)
+}
+
+func (m *Manager) GetStreamEndpoint(ctx context.Context, id string) (lib_model.StreamEndpoint, error) {
+	return m.gwEndpointHdl.GetStream(ctx, id)
+}
+
+func (m *Manager) SetStreamEndpoint(ctx context.Context, endpoint lib_model.StreamEndpointBase) (string, error) {
+	return m.SetStreamEndpointWithOptions(ctx, endpoint, lib_model.StreamEndpointOptions{})
+}
+
+func (m *Manager) SetStreamEndpointWithOptions(ctx context.Context, endpoint lib_model.StreamEndpointBase, options lib_model.StreamEndpointOptions) (string, error) {
+	return m.jobHandler.Create(ctx, fmt.Sprintf("set stream endpoint '%+v'", endpoint), func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		id, err := m.gwEndpointHdl.SetStream(ctx, endpoint, options.Force)
+		if err == nil {
+			err = ctx.Err()
+		}
+		if err != nil {
+			return nil, err
+		}
+		return id, nil
+	})
+}
+
+func (m *Manager) RemoveStreamEndpoint(ctx context.Context, id string) (string, error) {
+	return m.jobHandler.Create(ctx, fmt.Sprintf("remove stream endpoint '%s'", id), func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		err := m.gwEndpointHdl.RemoveStream(ctx, id)
+		if err == nil {
+			err = ctx.Err()
+		}
+		return nil, err
+	})
+}
+
+func (m *Manager) RemoveStreamEndpoints(ctx context.Context, filter lib_model.StreamEndpointFilter) (string, error) {
+	return m.jobHandler.Create(ctx, fmt.Sprintf("remove stream endpoints '%+v'", filter), func(ctx context.Context, cf context.CancelFunc) (any, error) {
+		defer cf()
+		err := m.gwEndpointHdl.RemoveAllStreams(ctx, filter)
+		if err == nil {
+			err = ctx.Err()
+		}
+		return nil, err
+	})
+}
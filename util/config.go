@@ -70,22 +70,96 @@ type KratosConfig struct {
 }
 
 type LogHandlerConfig struct {
-	Path       string `json:"path" env_var:"LOG_HANDLER_PATH"`
-	BufferSize int    `json:"buffer_size" env_var:"LOG_HANDLER_BUFFER_SIZE"`
+	Path             string   `json:"path" env_var:"LOG_HANDLER_PATH"`
+	BufferSize       int      `json:"buffer_size" env_var:"LOG_HANDLER_BUFFER_SIZE"`
+	PollInterval     int64    `json:"poll_interval" env_var:"LOG_HANDLER_POLL_INTERVAL"`
+	WatchInterval    int64    `json:"watch_interval" env_var:"LOG_HANDLER_WATCH_INTERVAL"`
+	AllowedDirs      []string `json:"allowed_dirs" env_var:"LOG_HANDLER_ALLOWED_DIRS"`
+	RotationInterval int64    `json:"rotation_interval" env_var:"LOG_HANDLER_ROTATION_INTERVAL"`
+}
+
+type DiagnosticsConfig struct {
+	WorkPath   string `json:"work_path" env_var:"DIAGNOSTICS_WORK_PATH"`
+	MaxBundles int    `json:"max_bundles" env_var:"DIAGNOSTICS_MAX_BUNDLES"`
+	LogLines   int    `json:"log_lines" env_var:"DIAGNOSTICS_LOG_LINES"`
+}
+
+type EndpointsHistoryConfig struct {
+	Path string `json:"path" env_var:"ENDPOINTS_HISTORY_PATH"`
+	Size int    `json:"size" env_var:"ENDPOINTS_HISTORY_SIZE"`
+}
+
+type EndpointHealthConfig struct {
+	Interval int64 `json:"interval" env_var:"ENDPOINT_HEALTH_INTERVAL"`
+	Timeout  int64 `json:"timeout" env_var:"ENDPOINT_HEALTH_TIMEOUT"`
+}
+
+type EndpointsAuthConfig struct {
+	HtpasswdPath    string `json:"htpasswd_path" env_var:"ENDPOINTS_AUTH_HTPASSWD_PATH"`
+	AuthRequestPath string `json:"auth_request_path" env_var:"ENDPOINTS_AUTH_REQUEST_PATH"`
+}
+
+type EndpointsStreamConfig struct {
+	ConfPath string `json:"conf_path" env_var:"ENDPOINTS_STREAM_CONF_PATH"`
+	PortMin  int    `json:"port_min" env_var:"ENDPOINTS_STREAM_PORT_MIN"`
+	PortMax  int    `json:"port_max" env_var:"ENDPOINTS_STREAM_PORT_MAX"`
+}
+
+type CertsConfig struct {
+	Path          string   `json:"path" env_var:"CERTS_PATH"`
+	SslConfPath   string   `json:"ssl_conf_path" env_var:"CERTS_SSL_CONF_PATH"`
+	Hostnames     []string `json:"hostnames" env_var:"CERTS_HOSTNAMES"`
+	IPs           []string `json:"ips" env_var:"CERTS_IPS"`
+	Validity      int64    `json:"validity" env_var:"CERTS_VALIDITY"`
+	RenewBefore   int64    `json:"renew_before" env_var:"CERTS_RENEW_BEFORE"`
+	WarnBefore    int64    `json:"warn_before" env_var:"CERTS_WARN_BEFORE"`
+	CheckInterval int64    `json:"check_interval" env_var:"CERTS_CHECK_INTERVAL"`
+}
+
+type EndpointTemplatesConfig struct {
+	StandardLocation         string `json:"standard_location" env_var:"ENDPOINT_TEMPLATES_STANDARD_LOCATION"`
+	StandardRewrite          string `json:"standard_rewrite" env_var:"ENDPOINT_TEMPLATES_STANDARD_REWRITE"`
+	StandardProxyPass        string `json:"standard_proxy_pass" env_var:"ENDPOINT_TEMPLATES_STANDARD_PROXY_PASS"`
+	DefaultGuiLocation       string `json:"default_gui_location" env_var:"ENDPOINT_TEMPLATES_DEFAULT_GUI_LOCATION"`
+	DefaultGuiProxyPass      string `json:"default_gui_proxy_pass" env_var:"ENDPOINT_TEMPLATES_DEFAULT_GUI_PROXY_PASS"`
+	AliasLocation            string `json:"alias_location" env_var:"ENDPOINT_TEMPLATES_ALIAS_LOCATION"`
+	AliasRewrite             string `json:"alias_rewrite" env_var:"ENDPOINT_TEMPLATES_ALIAS_REWRITE"`
+	AliasProxyPass           string `json:"alias_proxy_pass" env_var:"ENDPOINT_TEMPLATES_ALIAS_PROXY_PASS"`
+	SubdomainServerName      string `json:"subdomain_server_name" env_var:"ENDPOINT_TEMPLATES_SUBDOMAIN_SERVER_NAME"`
+	SubdomainRewrite         string `json:"subdomain_rewrite" env_var:"ENDPOINT_TEMPLATES_SUBDOMAIN_REWRITE"`
+	SubdomainProxyPass       string `json:"subdomain_proxy_pass" env_var:"ENDPOINT_TEMPLATES_SUBDOMAIN_PROXY_PASS"`
+	SubdomainAliasServerName string `json:"subdomain_alias_server_name" env_var:"ENDPOINT_TEMPLATES_SUBDOMAIN_ALIAS_SERVER_NAME"`
+	SubdomainAliasRewrite    string `json:"subdomain_alias_rewrite" env_var:"ENDPOINT_TEMPLATES_SUBDOMAIN_ALIAS_REWRITE"`
+	SubdomainAliasProxyPass  string `json:"subdomain_alias_proxy_pass" env_var:"ENDPOINT_TEMPLATES_SUBDOMAIN_ALIAS_PROXY_PASS"`
+	RedirectLocation         string `json:"redirect_location" env_var:"ENDPOINT_TEMPLATES_REDIRECT_LOCATION"`
+	StaticLocation           string `json:"static_location" env_var:"ENDPOINT_TEMPLATES_STATIC_LOCATION"`
 }
 
 type Config struct {
-	Logger            LoggerConfig      `json:"logger" env_var:"LOGGER_CONFIG"`
-	Socket            SocketConfig      `json:"socket" env_var:"SOCKET_CONFIG"`
-	Jobs              JobsConfig        `json:"jobs" env_var:"JOBS_CONFIG"`
-	CoreService       CoreServiceConfig `json:"core_service" env_var:"CORE_SERVICE_CONFIG"`
-	HttpClient        HttpClientConfig  `json:"http_client" env_var:"HTTP_CLIENT_CONFIG"`
-	Kratos            KratosConfig      `json:"kratos" env_var:"KRATOS_CONFIG"`
-	EndpointsConfPath string            `json:"endpoints_conf_path" env_var:"ENDPOINTS_CONF_PATH"`
-	ComposeFilePath   string            `json:"compose_file_path" env_var:"COMPOSE_FILE_PATH"`
-	CoreID            string            `json:"core_id" env_var:"CORE_ID"`
-	ImgPurgeDelay     int64             `json:"img_purge_delay" env_var:"IMG_PURGE_DELAY"`
-	LogHandler        LogHandlerConfig  `json:"log_handler" env_var:"LOG_HANDLER_CONFIG"`
+	Logger                    LoggerConfig            `json:"logger" env_var:"LOGGER_CONFIG"`
+	Socket                    SocketConfig            `json:"socket" env_var:"SOCKET_CONFIG"`
+	Jobs                      JobsConfig              `json:"jobs" env_var:"JOBS_CONFIG"`
+	CoreService               CoreServiceConfig       `json:"core_service" env_var:"CORE_SERVICE_CONFIG"`
+	HttpClient                HttpClientConfig        `json:"http_client" env_var:"HTTP_CLIENT_CONFIG"`
+	Kratos                    KratosConfig            `json:"kratos" env_var:"KRATOS_CONFIG"`
+	EndpointsConfPath         string                  `json:"endpoints_conf_path" env_var:"ENDPOINTS_CONF_PATH"`
+	EndpointTemplates         EndpointTemplatesConfig `json:"endpoint_templates" env_var:"ENDPOINT_TEMPLATES_CONFIG"`
+	EndpointsHistory          EndpointsHistoryConfig  `json:"endpoints_history" env_var:"ENDPOINTS_HISTORY_CONFIG"`
+	EndpointHealth            EndpointHealthConfig    `json:"endpoint_health" env_var:"ENDPOINT_HEALTH_CONFIG"`
+	EndpointsAuth             EndpointsAuthConfig     `json:"endpoints_auth" env_var:"ENDPOINTS_AUTH_CONFIG"`
+	EndpointsHttpConfPath     string                  `json:"endpoints_http_conf_path" env_var:"ENDPOINTS_HTTP_CONF_PATH"`
+	EndpointsUpstreamConfPath string                  `json:"endpoints_upstream_conf_path" env_var:"ENDPOINTS_UPSTREAM_CONF_PATH"`
+	EndpointsResolver         string                  `json:"endpoints_resolver" env_var:"ENDPOINTS_RESOLVER"`
+	EndpointsServerListen     string                  `json:"endpoints_server_listen" env_var:"ENDPOINTS_SERVER_LISTEN"`
+	EndpointsStaticPath       string                  `json:"endpoints_static_path" env_var:"ENDPOINTS_STATIC_PATH"`
+	EndpointsMaintenancePage  string                  `json:"endpoints_maintenance_page" env_var:"ENDPOINTS_MAINTENANCE_PAGE"`
+	EndpointsStream           EndpointsStreamConfig   `json:"endpoints_stream" env_var:"ENDPOINTS_STREAM_CONFIG"`
+	Certs                     CertsConfig             `json:"certs" env_var:"CERTS_CONFIG"`
+	ComposeFilePath           string                  `json:"compose_file_path" env_var:"COMPOSE_FILE_PATH"`
+	CoreID                    string                  `json:"core_id" env_var:"CORE_ID"`
+	ImgPurgeDelay             int64                   `json:"img_purge_delay" env_var:"IMG_PURGE_DELAY"`
+	LogHandler                LogHandlerConfig        `json:"log_handler" env_var:"LOG_HANDLER_CONFIG"`
+	Diagnostics               DiagnosticsConfig       `json:"diagnostics" env_var:"DIAGNOSTICS_CONFIG"`
 }
 
 func NewConfig(path string) (*Config, error) {
@@ -121,7 +195,52 @@ func NewConfig(path string) (*Config, error) {
 		},
 		ImgPurgeDelay: int64(time.Minute),
 		LogHandler: LogHandlerConfig{
-			BufferSize: 32768,
+			BufferSize:       32768,
+			PollInterval:     int64(time.Millisecond * 500),
+			WatchInterval:    int64(time.Second * 5),
+			RotationInterval: int64(time.Minute * 5),
+		},
+		EndpointTemplates: EndpointTemplatesConfig{
+			StandardLocation:         "/endpoints/deployment/{ref}/{path}",
+			StandardRewrite:          "/endpoints/deployment/{ref}/{path}(.*) /$1 break",
+			StandardProxyPass:        "http://{var}{port}{path}$1$is_args$args",
+			DefaultGuiLocation:       "/",
+			DefaultGuiProxyPass:      "http://{var}{port}{path}",
+			AliasLocation:            "/endpoints/alias/{path}",
+			AliasRewrite:             "/endpoints/alias/{path}(.*) /$1 break",
+			AliasProxyPass:           "http://{var}{port}{path}$1$is_args$args",
+			SubdomainServerName:      "{ref}.gateway.local",
+			SubdomainRewrite:         "^(/.*)$ $1 break",
+			SubdomainProxyPass:       "http://{var}{port}{path}$1$is_args$args",
+			SubdomainAliasServerName: "{path}.gateway.local",
+			SubdomainAliasRewrite:    "^(/.*)$ $1 break",
+			SubdomainAliasProxyPass:  "http://{var}{port}{path}$1$is_args$args",
+			RedirectLocation:         "/{path}",
+			StaticLocation:           "/{path}",
+		},
+		EndpointsHistory: EndpointsHistoryConfig{
+			Path: "./endpoints_history",
+			Size: 10,
+		},
+		EndpointsServerListen: "80",
+		EndpointHealth: EndpointHealthConfig{
+			Interval: int64(time.Second * 5),
+			Timeout:  int64(time.Second * 5),
+		},
+		EndpointsStream: EndpointsStreamConfig{
+			PortMin: 10000,
+			PortMax: 10099,
+		},
+		Certs: CertsConfig{
+			Validity:      int64(time.Hour * 24 * 365),
+			RenewBefore:   int64(time.Hour * 24 * 30),
+			WarnBefore:    int64(time.Hour * 24 * 14),
+			CheckInterval: int64(time.Hour * 12),
+		},
+		Diagnostics: DiagnosticsConfig{
+			WorkPath:   "./diagnostics",
+			MaxBundles: 3,
+			LogLines:   1000,
 		},
 	}
 	err := config_hdl.Load(&cfg, nil, map[reflect.Type]envldr.Parser{reflect.TypeOf(level.Off): sb_logger.LevelParser}, nil, path)
@@ -18,10 +18,23 @@ package util
 
 import (
 	"errors"
+	"fmt"
 	"github.com/SENERGY-Platform/mgw-core-manager/lib/model"
 	"net/http"
+	"strings"
 )
 
+// ExecError is returned if a command has been executed but did not complete successfully, e.g. due to a non-zero exit
+// status. Output contains the error reported for the execution.
+type ExecError struct {
+	Cmd    []string
+	Output string
+}
+
+func (e *ExecError) Error() string {
+	return fmt.Sprintf("executing '%s' failed: %s", strings.Join(e.Cmd, " "), e.Output)
+}
+
 func GetErrCode(err error) *int {
 	c := GetStatusCode(err)
 	if c > 0 {